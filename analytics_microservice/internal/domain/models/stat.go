@@ -3,4 +3,8 @@ package models
 type UrlStat struct {
 	UrlText string
 	UserId  int64
+	// Variant names the destination of a split link the access went to.
+	Variant string
+	// Source tells how the visitor reached the link, e.g. "qr"; empty for clicks.
+	Source string
 }
@@ -12,6 +12,8 @@ import (
 
 type GetStatsService interface {
 	GetURLStats(context.Context, string) (int64, error)
+	GetVariantStats(context.Context, string) (map[string]int64, error)
+	GetSourceStats(context.Context, string) (map[string]int64, error)
 	LogURLAccess(context.Context, string, int64) (bool, error)
 }
 
@@ -41,8 +43,20 @@ func (s *serverAPI) GetURLStats(
 		return nil, status.Error(codes.Internal, "failed to get stats")
 	}
 
+	variants, err := s.analyticsService.GetVariantStats(ctx, in.Url)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get stats")
+	}
+
+	sources, err := s.analyticsService.GetSourceStats(ctx, in.Url)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to get stats")
+	}
+
 	return &an.GetURLStatsResponse{
-		TotalAccesses: stats,
+		TotalAccesses:   stats,
+		VariantAccesses: variants,
+		SourceAccesses:  sources,
 	}, nil
 }
 
@@ -9,8 +9,10 @@ import (
 )
 
 type StatsStorage interface {
-	SaveStats(ctx context.Context, userID int64, urlText string) error
+	SaveStats(ctx context.Context, userID int64, urlText string, variant, source string) error
 	GetURLStats(ctx context.Context, url string) (int64, error)
+	GetVariantStats(ctx context.Context, url string) (map[string]int64, error)
+	GetSourceStats(ctx context.Context, url string) (map[string]int64, error)
 	LogURLAccess(ctx context.Context, url string, userId int64) (bool, error)
 }
 
@@ -32,7 +34,7 @@ func (s *AnalyticsService) SaveRecord(ctx context.Context, content []byte) error
 	if err != nil {
 		return err
 	}
-	err = s.statsStore.SaveStats(ctx, msg.UserId, msg.UrlText)
+	err = s.statsStore.SaveStats(ctx, msg.UserId, msg.UrlText, msg.Variant, msg.Source)
 	if err != nil {
 		s.log.Error("failed to save stats", slog.String("err", err.Error()))
 		return err
@@ -52,6 +54,28 @@ func (s *AnalyticsService) GetURLStats(ctx context.Context, url string) (int64,
 	return stats, nil
 }
 
+// GetVariantStats returns the accesses of a split link per variant.
+func (s *AnalyticsService) GetVariantStats(ctx context.Context, url string) (map[string]int64, error) {
+	stats, err := s.statsStore.GetVariantStats(ctx, url)
+	if err != nil {
+		s.log.Error("failed to get variant stats", slog.String("err", err.Error()))
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+// GetSourceStats returns the accesses of a link per source other than a plain click.
+func (s *AnalyticsService) GetSourceStats(ctx context.Context, url string) (map[string]int64, error) {
+	stats, err := s.statsStore.GetSourceStats(ctx, url)
+	if err != nil {
+		s.log.Error("failed to get source stats", slog.String("err", err.Error()))
+		return nil, err
+	}
+
+	return stats, nil
+}
+
 func (s *AnalyticsService) LogURLAccess(ctx context.Context, url string, userId int64) (bool, error) {
 	success, err := s.statsStore.LogURLAccess(ctx, url, userId)
 	if err != nil {
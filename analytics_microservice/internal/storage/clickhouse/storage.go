@@ -3,6 +3,7 @@ package clickhouse
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"github.com/ClickHouse/clickhouse-go/v2"
 	"github.com/ClickHouse/clickhouse-go/v2/lib/driver"
@@ -78,6 +79,34 @@ func (c *ClickhouseStorage) init(ctx context.Context) error {
 			sumState(toInt64(1)) AS counter
 		FROM source
 		GROUP BY id, user_id`,
+		`CREATE TABLE IF NOT EXISTS variant_counters (
+			id String,
+			variant String,
+			counter AggregateFunction(sum, Int64)
+		) ENGINE = AggregatingMergeTree()
+		ORDER BY (id, variant)`,
+		`CREATE MATERIALIZED VIEW IF NOT EXISTS variant_counters_mv TO variant_counters
+		AS SELECT
+			JSONExtractString(value, 'url') AS id,
+			JSONExtractString(value, 'variant') AS variant,
+			sumState(toInt64(1)) AS counter
+		FROM source
+		WHERE JSONExtractInt(value, 'user_id') = 0 AND variant != ''
+		GROUP BY id, variant`,
+		`CREATE TABLE IF NOT EXISTS source_counters (
+			id String,
+			source String,
+			counter AggregateFunction(sum, Int64)
+		) ENGINE = AggregatingMergeTree()
+		ORDER BY (id, source)`,
+		`CREATE MATERIALIZED VIEW IF NOT EXISTS source_counters_mv TO source_counters
+		AS SELECT
+			JSONExtractString(value, 'url') AS id,
+			JSONExtractString(value, 'source') AS source,
+			sumState(toInt64(1)) AS counter
+		FROM source
+		WHERE JSONExtractInt(value, 'user_id') = 0 AND source != ''
+		GROUP BY id, source`,
 	}
 
 	for _, query := range queries {
@@ -88,11 +117,62 @@ func (c *ClickhouseStorage) init(ctx context.Context) error {
 	return nil
 }
 
-func (c *ClickhouseStorage) SaveStats(ctx context.Context, userID int64, urlText string) error {
-	value := fmt.Sprintf(`{"url":"%s", "user_id": %d}`, urlText, userID)
+func (c *ClickhouseStorage) SaveStats(ctx context.Context, userID int64, urlText string, variant, source string) error {
+	value, err := json.Marshal(struct {
+		URL     string `json:"url"`
+		UserID  int64  `json:"user_id"`
+		Variant string `json:"variant,omitempty"`
+		Source  string `json:"source,omitempty"`
+	}{urlText, userID, variant, source})
+	if err != nil {
+		return err
+	}
 	query := `INSERT INTO source (value) VALUES (?)`
-	err := c.db.Exec(ctx, query, value)
-	return err
+	return c.db.Exec(ctx, query, string(value))
+}
+
+func (c *ClickhouseStorage) GetVariantStats(ctx context.Context, url string) (map[string]int64, error) {
+	query := `SELECT variant, sumMerge(counter) AS counter FROM variant_counters WHERE id = ? GROUP BY variant`
+	rows, err := c.db.Query(ctx, query, url)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	stats := make(map[string]int64)
+	for rows.Next() {
+		var (
+			variant string
+			total   int64
+		)
+		if err := rows.Scan(&variant, &total); err != nil {
+			return nil, err
+		}
+		stats[variant] = total
+	}
+	return stats, rows.Err()
+}
+
+func (c *ClickhouseStorage) GetSourceStats(ctx context.Context, url string) (map[string]int64, error) {
+	query := `SELECT source, sumMerge(counter) AS counter FROM source_counters WHERE id = ? GROUP BY source`
+	rows, err := c.db.Query(ctx, query, url)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	stats := make(map[string]int64)
+	for rows.Next() {
+		var (
+			source string
+			total  int64
+		)
+		if err := rows.Scan(&source, &total); err != nil {
+			return nil, err
+		}
+		stats[source] = total
+	}
+	return stats, rows.Err()
 }
 
 func (c *ClickhouseStorage) GetURLStats(ctx context.Context, url string) (int64, error) {
@@ -15,6 +15,33 @@ type Config struct {
 	AnAddr   string        `yaml:"an_address" env-required:"true"`
 	Port     string        `yaml:"port"`
 	Timeout  time.Duration `yaml:"timeout"`
+	// BaseURL is the scheme and host short links are served on, e.g. https://sho.rt.
+	// It is encoded in QR codes, which must not depend on the Host of a request.
+	BaseURL string `yaml:"base_url" env-required:"true"`
+	// CountryHeader names the header a CDN or proxy puts the visitor's ISO country code in.
+	CountryHeader string `yaml:"country_header" env-default:"CF-IPCountry"`
+	// Crawlers are case-insensitive substrings of the User-Agent of link
+	// unfurlers, served the social card of a link instead of a redirect.
+	Crawlers []string `yaml:"crawlers" env-default:"facebookexternalhit,Facebot,Twitterbot,Slackbot,LinkedInBot,Discordbot,TelegramBot,WhatsApp,Pinterest,redditbot,Applebot,SkypeUriPreview,vkShare,Iframely,Embedly"`
+	// Apps describes the mobile apps deep links open, published in the
+	// association files under /.well-known.
+	Apps Apps `yaml:"apps"`
+	QR   QR   `yaml:"qr"`
+}
+
+type QR struct {
+	// LogoPath is a PNG or JPEG drawn in the center of codes requested with logo=true.
+	LogoPath string `yaml:"logo_path"`
+}
+
+type Apps struct {
+	// IOSAppIDs are the "<team id>.<bundle id>" of the iOS apps handling universal links.
+	IOSAppIDs []string `yaml:"ios_app_ids"`
+	// IOSPaths are the path patterns the iOS apps handle.
+	IOSPaths []string `yaml:"ios_paths" env-default:"/*"`
+	// AndroidPackage and AndroidFingerprints identify the Android app handling app links.
+	AndroidPackage      string   `yaml:"android_package"`
+	AndroidFingerprints []string `yaml:"android_sha256_cert_fingerprints"`
 }
 
 func MustLoad() *Config {
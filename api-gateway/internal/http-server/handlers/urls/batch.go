@@ -0,0 +1,78 @@
+package urls
+
+import (
+	clientConn "apiGW/internal/http-server/client"
+	"apiGW/internal/http-server/middleware"
+	"encoding/json"
+	us "github.com/yberikov/us-protos/gen/us-microservice"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log/slog"
+	"net/http"
+)
+
+type RequestCreateUrls struct {
+	Items []RequestCreateUrl `json:"items"`
+}
+
+// BatchItem is the outcome of one item of a batch, Status being the HTTP
+// status the item would have got from /createUrl.
+type BatchItem struct {
+	ShortUrl string `json:"short_url,omitempty"`
+	Status   int    `json:"status"`
+	Error    string `json:"error,omitempty"`
+}
+
+type ResponseCreateUrls struct {
+	Results []BatchItem `json:"results"`
+}
+
+// NewCreateUrls creates many links at once. Items succeed or fail on their
+// own, so the response is 200 whenever the batch itself was accepted and the
+// outcome of every item is reported in its results, in request order.
+func NewCreateUrls(client *clientConn.ClientConn) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req RequestCreateUrls
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "Invalid request payload", http.StatusBadRequest)
+			return
+		}
+
+		userID, ok := r.Context().Value(middleware.UserIDKey).(int64)
+		if !ok {
+			http.Error(w, "User ID not found in context", http.StatusInternalServerError)
+			return
+		}
+
+		ownerName, _ := r.Context().Value(middleware.DisplayNameKey).(string)
+		grpcReq := &us.ShortenUrlsRequest{
+			UserId: userID,
+			Items:  make([]*us.ShortenUrlRequest, 0, len(req.Items)),
+		}
+		for _, item := range req.Items {
+			grpcReq.Items = append(grpcReq.Items, item.toProto(userID, ownerName))
+		}
+
+		grpcResp, err := client.UrlShortenerClient.ShortenUrls(r.Context(), grpcReq)
+		if err != nil {
+			grpcError, _ := status.FromError(err)
+			http.Error(w, grpcError.Message(), httpStatus(grpcError.Code()))
+			return
+		}
+
+		resp := ResponseCreateUrls{Results: make([]BatchItem, 0, len(grpcResp.Results))}
+		created := 0
+		for _, res := range grpcResp.Results {
+			item := BatchItem{ShortUrl: res.ShortUrl, Status: http.StatusCreated}
+			if code := codes.Code(res.Code); code != codes.OK {
+				item = BatchItem{Status: httpStatus(code), Error: res.Error}
+			} else {
+				created++
+			}
+			resp.Results = append(resp.Results, item)
+		}
+
+		client.Log.Info("creating url batch", slog.Int("size", len(req.Items)), slog.Int("created", created))
+		json.NewEncoder(w).Encode(resp)
+	}
+}
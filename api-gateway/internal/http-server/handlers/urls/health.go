@@ -0,0 +1,67 @@
+package urls
+
+import (
+	clientConn "apiGW/internal/http-server/client"
+	"apiGW/internal/http-server/middleware"
+	"encoding/json"
+	"github.com/go-chi/chi/v5"
+	us "github.com/yberikov/us-protos/gen/us-microservice"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"time"
+)
+
+// Health is the outcome of the periodic checks of a link's destination.
+type Health struct {
+	// Status is the HTTP status of the last check, omitted when no response came back.
+	Status    int32     `json:"status,omitempty"`
+	Error     string    `json:"error,omitempty"`
+	LatencyMs int64     `json:"latency_ms"`
+	CheckedAt time.Time `json:"checked_at"`
+	// LastHealthyAt is when the destination last answered with a 2xx or 3xx status.
+	LastHealthyAt *time.Time `json:"last_healthy_at,omitempty"`
+	Failures      int32      `json:"failures"`
+	Broken        bool       `json:"broken"`
+}
+
+type ResponseLinkHealth struct {
+	// Health is null until the destination has been checked.
+	Health *Health `json:"health"`
+}
+
+// NewGetLinkHealth returns the health of the destination of one of the authenticated user's links.
+func NewGetLinkHealth(client *clientConn.ClientConn) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, ok := r.Context().Value(middleware.UserIDKey).(int64)
+		if !ok {
+			http.Error(w, "User ID not found in context", http.StatusInternalServerError)
+			return
+		}
+
+		grpcReq := &us.GetLinkHealthRequest{ShortUrl: chi.URLParam(r, "alias"), UserId: userID}
+		grpcResp, err := client.UrlShortenerClient.GetLinkHealth(r.Context(), grpcReq)
+		if err != nil {
+			grpcError, _ := status.FromError(err)
+			http.Error(w, grpcError.Message(), httpStatus(grpcError.Code()))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(ResponseLinkHealth{Health: toHealth(grpcResp.Health)})
+	}
+}
+
+func toHealth(h *us.LinkHealth) *Health {
+	if h == nil {
+		return nil
+	}
+	return &Health{
+		Status:        h.Status,
+		Error:         h.Error,
+		LatencyMs:     h.LatencyMs,
+		CheckedAt:     h.CheckedAt.AsTime(),
+		LastHealthyAt: optionalTime(h.LastHealthyAt),
+		Failures:      h.Failures,
+		Broken:        h.Broken,
+	}
+}
@@ -0,0 +1,311 @@
+package urls
+
+import (
+	clientConn "apiGW/internal/http-server/client"
+	"apiGW/internal/http-server/middleware"
+	"encoding/json"
+	"github.com/go-chi/chi/v5"
+	us "github.com/yberikov/us-protos/gen/us-microservice"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+type Link struct {
+	Alias       string       `json:"alias"`
+	OriginalUrl string       `json:"original_url"`
+	Title       string       `json:"title,omitempty"`
+	CreatedAt   time.Time    `json:"created_at"`
+	Disabled    bool         `json:"disabled"`
+	ExpiresAt   *time.Time   `json:"expires_at,omitempty"`
+	ActiveFrom  *time.Time   `json:"active_from,omitempty"`
+	MaxClicks   int64        `json:"max_clicks,omitempty"`
+	Rules       []Rule       `json:"rules,omitempty"`
+	Variants    []Variant    `json:"variants,omitempty"`
+	Passthrough *Passthrough `json:"passthrough,omitempty"`
+	Redirect    *Redirect    `json:"redirect,omitempty"`
+	UtmTemplate string       `json:"utm_template,omitempty"`
+	DeepLink    *DeepLink    `json:"deep_link,omitempty"`
+	OwnerName   string       `json:"owner_name,omitempty"`
+	Social      *Social      `json:"social,omitempty"`
+	Page        *Page        `json:"page,omitempty"`
+	Health      *Health      `json:"health,omitempty"`
+
+	PasswordProtected bool `json:"password_protected"`
+}
+
+// Page is the metadata of the destination page, fetched after the link was created.
+type Page struct {
+	Title       string    `json:"title,omitempty"`
+	Description string    `json:"description,omitempty"`
+	Favicon     string    `json:"favicon,omitempty"`
+	FetchedAt   time.Time `json:"fetched_at"`
+}
+
+// Social is what chat apps and social networks show when the link is pasted,
+// instead of the metadata of the destination.
+type Social struct {
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	// Image is an absolute http(s) URL.
+	Image string `json:"image,omitempty"`
+}
+
+// DeepLink holds the app URIs tried before the destination on iOS and Android.
+type DeepLink struct {
+	IOS     string `json:"ios,omitempty"`
+	Android string `json:"android,omitempty"`
+	// Fallback is where visitors without the app go; the destination when empty.
+	Fallback string `json:"fallback,omitempty"`
+}
+
+// Redirect customizes the redirect response of a link.
+type Redirect struct {
+	// Status is 301, 302, 307 or 308; omitted means 302.
+	Status         int32  `json:"status,omitempty"`
+	CacheControl   string `json:"cache_control,omitempty"`
+	ReferrerPolicy string `json:"referrer_policy,omitempty"`
+	RobotsTag      string `json:"robots_tag,omitempty"`
+}
+
+// Passthrough controls which parts of a redirect request reach the destination.
+type Passthrough struct {
+	Path  bool `json:"path"`
+	Query bool `json:"query"`
+	// Conflict is "destination" (default), "request" or "append".
+	Conflict string `json:"conflict,omitempty"`
+}
+
+// Variant is a destination of a split link with its share of the traffic.
+type Variant struct {
+	Name   string `json:"name,omitempty"`
+	Url    string `json:"url"`
+	Weight int32  `json:"weight"`
+}
+
+// Rule sends visitors matching all of its non-empty conditions to Url instead of the original URL.
+type Rule struct {
+	OS        []string `json:"os,omitempty"`
+	Languages []string `json:"languages,omitempty"`
+	Countries []string `json:"countries,omitempty"`
+	UserAgent string   `json:"user_agent,omitempty"`
+	Url       string   `json:"url"`
+}
+
+type ResponseListLinks struct {
+	Links      []Link `json:"links"`
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// NewListLinks lists the links of the authenticated user.
+// Query parameters: limit, cursor, q (destination substring) and order=asc|desc.
+func NewListLinks(client *clientConn.ClientConn) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, ok := r.Context().Value(middleware.UserIDKey).(int64)
+		if !ok {
+			http.Error(w, "User ID not found in context", http.StatusInternalServerError)
+			return
+		}
+
+		query := r.URL.Query()
+		grpcReq := &us.ListUserUrlsRequest{
+			UserId: userID,
+			Cursor: query.Get("cursor"),
+			Query:  query.Get("q"),
+		}
+		if limit := query.Get("limit"); limit != "" {
+			n, err := strconv.ParseInt(limit, 10, 32)
+			if err != nil || n <= 0 {
+				http.Error(w, "limit must be a positive integer", http.StatusBadRequest)
+				return
+			}
+			grpcReq.PageSize = int32(n)
+		}
+		switch query.Get("order") {
+		case "", "desc":
+		case "asc":
+			grpcReq.OldestFirst = true
+		default:
+			http.Error(w, "order must be asc or desc", http.StatusBadRequest)
+			return
+		}
+
+		grpcResp, err := client.UrlShortenerClient.ListUserUrls(r.Context(), grpcReq)
+		if err != nil {
+			grpcError, _ := status.FromError(err)
+			http.Error(w, grpcError.Message(), httpStatus(grpcError.Code()))
+			return
+		}
+
+		resp := ResponseListLinks{Links: make([]Link, 0, len(grpcResp.Links)), NextCursor: grpcResp.NextCursor}
+		for _, l := range grpcResp.Links {
+			resp.Links = append(resp.Links, toLink(l))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}
+}
+
+type RequestUpdateLink struct {
+	OriginalUrl string `json:"original_url"`
+}
+
+// NewUpdateLink changes the destination of one of the authenticated user's links.
+func NewUpdateLink(client *clientConn.ClientConn) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req RequestUpdateLink
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "Invalid request payload", http.StatusBadRequest)
+			return
+		}
+
+		userID, ok := r.Context().Value(middleware.UserIDKey).(int64)
+		if !ok {
+			http.Error(w, "User ID not found in context", http.StatusInternalServerError)
+			return
+		}
+
+		grpcReq := &us.UpdateUrlRequest{
+			ShortUrl:    chi.URLParam(r, "alias"),
+			UserId:      userID,
+			OriginalUrl: req.OriginalUrl,
+		}
+		grpcResp, err := client.UrlShortenerClient.UpdateUrl(r.Context(), grpcReq)
+		if err != nil {
+			grpcError, _ := status.FromError(err)
+			http.Error(w, grpcError.Message(), httpStatus(grpcError.Code()))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(toLink(grpcResp.Link))
+	}
+}
+
+// NewDeleteLink deletes one of the authenticated user's links.
+func NewDeleteLink(client *clientConn.ClientConn) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, ok := r.Context().Value(middleware.UserIDKey).(int64)
+		if !ok {
+			http.Error(w, "User ID not found in context", http.StatusInternalServerError)
+			return
+		}
+
+		grpcReq := &us.DeleteUrlRequest{ShortUrl: chi.URLParam(r, "alias"), UserId: userID}
+		if _, err := client.UrlShortenerClient.DeleteUrl(r.Context(), grpcReq); err != nil {
+			grpcError, _ := status.FromError(err)
+			http.Error(w, grpcError.Message(), httpStatus(grpcError.Code()))
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+// NewDisableLink disables, or re-enables when enable is set, one of the authenticated user's links.
+func NewDisableLink(client *clientConn.ClientConn, enable bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, ok := r.Context().Value(middleware.UserIDKey).(int64)
+		if !ok {
+			http.Error(w, "User ID not found in context", http.StatusInternalServerError)
+			return
+		}
+
+		grpcReq := &us.DisableUrlRequest{ShortUrl: chi.URLParam(r, "alias"), UserId: userID, Enable: enable}
+		grpcResp, err := client.UrlShortenerClient.DisableUrl(r.Context(), grpcReq)
+		if err != nil {
+			grpcError, _ := status.FromError(err)
+			http.Error(w, grpcError.Message(), httpStatus(grpcError.Code()))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(toLink(grpcResp.Link))
+	}
+}
+
+func toLink(l *us.Link) Link {
+	return Link{
+		Alias:       l.Alias,
+		OriginalUrl: l.OriginalUrl,
+		Title:       l.Title,
+		CreatedAt:   l.CreatedAt.AsTime(),
+		Disabled:    l.Disabled,
+		ExpiresAt:   optionalTime(l.ExpiresAt),
+		ActiveFrom:  optionalTime(l.ActiveFrom),
+		MaxClicks:   l.MaxClicks,
+		Rules:       toRules(l.Rules),
+		Variants:    toVariants(l.Variants),
+		Passthrough: toPassthrough(l.Passthrough),
+		Redirect:    toRedirect(l.Redirect),
+		UtmTemplate: l.UtmTemplate,
+		DeepLink:    toDeepLink(l.DeepLink),
+		OwnerName:   l.OwnerName,
+		Social:      toSocial(l.Social),
+		Page:        toPage(l.Page),
+		Health:      toHealth(l.Health),
+
+		PasswordProtected: l.PasswordProtected,
+	}
+}
+
+func toPassthrough(p *us.Passthrough) *Passthrough {
+	if p == nil {
+		return nil
+	}
+	return &Passthrough{Path: p.Path, Query: p.Query, Conflict: p.Conflict}
+}
+
+func toPage(p *us.PageMetadata) *Page {
+	if p == nil {
+		return nil
+	}
+	return &Page{Title: p.Title, Description: p.Description, Favicon: p.Favicon, FetchedAt: p.FetchedAt.AsTime()}
+}
+
+func toSocial(s *us.SocialCard) *Social {
+	if s == nil {
+		return nil
+	}
+	return &Social{Title: s.Title, Description: s.Description, Image: s.Image}
+}
+
+func toDeepLink(d *us.DeepLink) *DeepLink {
+	if d == nil {
+		return nil
+	}
+	return &DeepLink{IOS: d.Ios, Android: d.Android, Fallback: d.Fallback}
+}
+
+func toRedirect(r *us.RedirectOptions) *Redirect {
+	if r == nil {
+		return nil
+	}
+	return &Redirect{Status: r.Status, CacheControl: r.CacheControl, ReferrerPolicy: r.ReferrerPolicy, RobotsTag: r.RobotsTag}
+}
+
+func toVariants(variants []*us.Variant) []Variant {
+	out := make([]Variant, 0, len(variants))
+	for _, v := range variants {
+		out = append(out, Variant{Name: v.Name, Url: v.Url, Weight: v.Weight})
+	}
+	return out
+}
+
+func toRules(rules []*us.TargetRule) []Rule {
+	out := make([]Rule, 0, len(rules))
+	for _, r := range rules {
+		out = append(out, Rule{OS: r.Os, Languages: r.Languages, Countries: r.Countries, UserAgent: r.UserAgent, Url: r.Url})
+	}
+	return out
+}
+
+func optionalTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
@@ -0,0 +1,70 @@
+package urls
+
+import (
+	clientConn "apiGW/internal/http-server/client"
+	"apiGW/internal/http-server/pages"
+	"github.com/go-chi/chi/v5"
+	an "github.com/yberikov/us-protos/gen/analytics-microservice"
+	us "github.com/yberikov/us-protos/gen/us-microservice"
+	"google.golang.org/grpc/status"
+	"log/slog"
+	"net/http"
+	"net/url"
+)
+
+// previewParam=1 on a short link shows its preview page instead of redirecting.
+const previewParam = "preview"
+
+// statusActive is the preview status of links that redirect.
+const statusActive = "active"
+
+// NewPreview renders the preview page of a short link, served on /{alias}+
+// and /{alias}?preview=1. It neither redirects nor counts a click.
+func NewPreview(client *clientConn.ClientConn) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		renderPreview(w, r, client, chi.URLParam(r, "alias"), "")
+	}
+}
+
+// renderPreview renders the preview page of alias. With an empty dest the
+// page continues to the short link itself; otherwise it is the interstitial
+// of a flagged link and continues straight to dest.
+func renderPreview(w http.ResponseWriter, r *http.Request, client *clientConn.ClientConn, alias, dest string) {
+	grpcResp, err := client.UrlShortenerClient.GetLinkPreview(r.Context(), &us.GetLinkPreviewRequest{ShortUrl: alias})
+	if err != nil {
+		grpcError, _ := status.FromError(err)
+		http.Error(w, grpcError.Message(), httpStatus(grpcError.Code()))
+		return
+	}
+
+	p := pages.LinkPreview{
+		Alias:       alias,
+		Destination: grpcResp.OriginalUrl,
+		Title:       grpcResp.Title,
+		Owner:       grpcResp.OwnerName,
+		CreatedAt:   grpcResp.CreatedAt.AsTime(),
+		Status:      grpcResp.Status,
+		Flagged:     grpcResp.Flagged,
+		Protected:   grpcResp.PasswordProtected,
+		Varies:      grpcResp.Varies,
+	}
+	if page := grpcResp.Page; page != nil {
+		p.PageTitle, p.PageDescription, p.Favicon = page.Title, page.Description, page.Favicon
+	}
+	switch {
+	case dest != "":
+		p.Destination, p.Continue, p.Varies = dest, dest, false
+	case grpcResp.Status == statusActive:
+		p.Continue = "/" + url.PathEscape(alias)
+	}
+
+	// The page is still useful without the click count.
+	stats, err := client.AnalyticsClient.GetURLStats(r.Context(), &an.GetURLStatsRequest{Url: alias})
+	if err != nil {
+		client.Log.Warn("failed to get clicks for preview", slog.String("alias", alias), slog.String("err", err.Error()))
+	} else {
+		p.Clicks = &stats.TotalAccesses
+	}
+
+	pages.Preview(w, p)
+}
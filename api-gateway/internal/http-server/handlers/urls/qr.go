@@ -0,0 +1,154 @@
+package urls
+
+import (
+	clientConn "apiGW/internal/http-server/client"
+	"apiGW/internal/qr"
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/go-chi/chi/v5"
+	us "github.com/yberikov/us-protos/gen/us-microservice"
+	"google.golang.org/grpc/status"
+	"image"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Scans of QR codes carry sourceParam=sourceQR so their access events can be
+// told apart from clicks. The parameter is not forwarded to the destination.
+const (
+	sourceParam = "source"
+	sourceQR    = "qr"
+)
+
+// Bounds and defaults of the QR code query parameters.
+const (
+	defaultQRSize   = 256
+	minQRSize       = 64
+	maxQRSize       = 2048
+	defaultQRMargin = 4
+	maxQRMargin     = 16
+)
+
+// NewGetQR renders the QR code of a short link. baseURL is the scheme and host
+// encoded in the code; logo is the image drawn for logo=true, nil when none is
+// configured. Unknown aliases get a 404 rather than a code pointing nowhere.
+// Query parameters: format=png|svg, size, level=L|M|Q|H, margin, fg, bg and logo.
+func NewGetQR(client *clientConn.ClientConn, baseURL string, logo image.Image) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		alias := chi.URLParam(r, "alias")
+		query := r.URL.Query()
+
+		var opts qr.Options
+		var err error
+		if opts.Size, err = intParam(query, "size", defaultQRSize, minQRSize, maxQRSize); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if opts.Margin, err = intParam(query, "margin", defaultQRMargin, 0, maxQRMargin); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if opts.Level, err = qr.ParseLevel(withDefault(query.Get("level"), "M")); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if opts.Foreground, err = qr.ParseColor(withDefault(query.Get("fg"), "000000")); err != nil {
+			http.Error(w, "fg: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		if opts.Background, err = qr.ParseColor(withDefault(query.Get("bg"), "ffffff")); err != nil {
+			http.Error(w, "bg: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		if v := query.Get("logo"); v != "" {
+			withLogo, err := strconv.ParseBool(v)
+			if err != nil {
+				http.Error(w, "logo must be true or false", http.StatusBadRequest)
+				return
+			}
+			if withLogo && logo == nil {
+				http.Error(w, "no logo is configured", http.StatusBadRequest)
+				return
+			}
+			if withLogo {
+				opts.Logo = logo
+			}
+		}
+
+		if _, err := client.UrlShortenerClient.GetLinkPreview(r.Context(), &us.GetLinkPreviewRequest{ShortUrl: alias}); err != nil {
+			grpcError, _ := status.FromError(err)
+			http.Error(w, grpcError.Message(), httpStatus(grpcError.Code()))
+			return
+		}
+
+		content := shortURL(baseURL, alias) + "?" + sourceParam + "=" + sourceQR
+
+		var buf bytes.Buffer
+		contentType := "image/png"
+		switch query.Get("format") {
+		case "", "png":
+			err = qr.PNG(&buf, content, opts)
+		case "svg":
+			contentType = "image/svg+xml"
+			err = qr.SVG(&buf, content, opts)
+		default:
+			http.Error(w, "format must be png or svg", http.StatusBadRequest)
+			return
+		}
+		if err != nil {
+			if errors.Is(err, qr.ErrTooSmall) {
+				http.Error(w, err.Error(), http.StatusBadRequest)
+				return
+			}
+			http.Error(w, "failed to render QR code", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("Cache-Control", "public, max-age=86400")
+		w.Write(buf.Bytes())
+	}
+}
+
+// shortURL returns the public URL of alias.
+func shortURL(baseURL, alias string) string {
+	return strings.TrimSuffix(baseURL, "/") + "/" + url.PathEscape(alias)
+}
+
+// scanSource removes the marker of QR scans from a raw query, keeping the
+// other parameters as they are, and returns sourceQR if it was there.
+func scanSource(rawQuery string) (query, source string) {
+	var kept []string
+	for _, pair := range strings.Split(rawQuery, "&") {
+		if pair == sourceParam+"="+sourceQR {
+			source = sourceQR
+			continue
+		}
+		if pair != "" {
+			kept = append(kept, pair)
+		}
+	}
+	return strings.Join(kept, "&"), source
+}
+
+func intParam(query url.Values, name string, def, lo, hi int) (int, error) {
+	v := query.Get(name)
+	if v == "" {
+		return def, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n < lo || n > hi {
+		return 0, fmt.Errorf("%s must be an integer between %d and %d", name, lo, hi)
+	}
+	return n, nil
+}
+
+func withDefault(v, def string) string {
+	if v == "" {
+		return def
+	}
+	return v
+}
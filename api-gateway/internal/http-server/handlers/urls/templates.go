@@ -0,0 +1,199 @@
+package urls
+
+import (
+	clientConn "apiGW/internal/http-server/client"
+	"apiGW/internal/http-server/middleware"
+	"encoding/json"
+	"github.com/go-chi/chi/v5"
+	us "github.com/yberikov/us-protos/gen/us-microservice"
+	"google.golang.org/grpc/status"
+	"net/http"
+	"time"
+)
+
+// UtmTemplate is a reusable set of utm_* parameters; empty parameters are not set.
+type UtmTemplate struct {
+	Id        string    `json:"id"`
+	Name      string    `json:"name"`
+	Source    string    `json:"source,omitempty"`
+	Medium    string    `json:"medium,omitempty"`
+	Campaign  string    `json:"campaign,omitempty"`
+	Term      string    `json:"term,omitempty"`
+	Content   string    `json:"content,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type RequestTemplate struct {
+	Name     string `json:"name"`
+	Source   string `json:"source,omitempty"`
+	Medium   string `json:"medium,omitempty"`
+	Campaign string `json:"campaign,omitempty"`
+	Term     string `json:"term,omitempty"`
+	Content  string `json:"content,omitempty"`
+	// Propagate re-tags the links created with the template on update.
+	Propagate bool `json:"propagate,omitempty"`
+}
+
+type ResponseUpdateTemplate struct {
+	Template     UtmTemplate `json:"template"`
+	UpdatedLinks int64       `json:"updated_links"`
+}
+
+func (req RequestTemplate) toProto(id string, userID int64) *us.UtmTemplate {
+	return &us.UtmTemplate{
+		Id:       id,
+		UserId:   userID,
+		Name:     req.Name,
+		Source:   req.Source,
+		Medium:   req.Medium,
+		Campaign: req.Campaign,
+		Term:     req.Term,
+		Content:  req.Content,
+	}
+}
+
+// NewCreateTemplate creates a UTM template for the authenticated user.
+func NewCreateTemplate(client *clientConn.ClientConn) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req RequestTemplate
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "Invalid request payload", http.StatusBadRequest)
+			return
+		}
+
+		userID, ok := r.Context().Value(middleware.UserIDKey).(int64)
+		if !ok {
+			http.Error(w, "User ID not found in context", http.StatusInternalServerError)
+			return
+		}
+
+		grpcReq := &us.CreateUtmTemplateRequest{Template: req.toProto("", userID)}
+		grpcResp, err := client.UrlShortenerClient.CreateUtmTemplate(r.Context(), grpcReq)
+		if err != nil {
+			grpcError, _ := status.FromError(err)
+			http.Error(w, grpcError.Message(), httpStatus(grpcError.Code()))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(toTemplate(grpcResp.Template))
+	}
+}
+
+// NewListTemplates lists the UTM templates of the authenticated user.
+func NewListTemplates(client *clientConn.ClientConn) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, ok := r.Context().Value(middleware.UserIDKey).(int64)
+		if !ok {
+			http.Error(w, "User ID not found in context", http.StatusInternalServerError)
+			return
+		}
+
+		grpcResp, err := client.UrlShortenerClient.ListUtmTemplates(r.Context(), &us.ListUtmTemplatesRequest{UserId: userID})
+		if err != nil {
+			grpcError, _ := status.FromError(err)
+			http.Error(w, grpcError.Message(), httpStatus(grpcError.Code()))
+			return
+		}
+
+		templates := make([]UtmTemplate, 0, len(grpcResp.Templates))
+		for _, t := range grpcResp.Templates {
+			templates = append(templates, toTemplate(t))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(templates)
+	}
+}
+
+// NewGetTemplate returns one of the authenticated user's UTM templates.
+func NewGetTemplate(client *clientConn.ClientConn) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, ok := r.Context().Value(middleware.UserIDKey).(int64)
+		if !ok {
+			http.Error(w, "User ID not found in context", http.StatusInternalServerError)
+			return
+		}
+
+		grpcReq := &us.GetUtmTemplateRequest{Id: chi.URLParam(r, "id"), UserId: userID}
+		grpcResp, err := client.UrlShortenerClient.GetUtmTemplate(r.Context(), grpcReq)
+		if err != nil {
+			grpcError, _ := status.FromError(err)
+			http.Error(w, grpcError.Message(), httpStatus(grpcError.Code()))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(toTemplate(grpcResp.Template))
+	}
+}
+
+// NewUpdateTemplate replaces one of the authenticated user's UTM templates.
+// With "propagate" the links created with it are re-tagged as well.
+func NewUpdateTemplate(client *clientConn.ClientConn) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req RequestTemplate
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, "Invalid request payload", http.StatusBadRequest)
+			return
+		}
+
+		userID, ok := r.Context().Value(middleware.UserIDKey).(int64)
+		if !ok {
+			http.Error(w, "User ID not found in context", http.StatusInternalServerError)
+			return
+		}
+
+		grpcReq := &us.UpdateUtmTemplateRequest{
+			Template:  req.toProto(chi.URLParam(r, "id"), userID),
+			Propagate: req.Propagate,
+		}
+		grpcResp, err := client.UrlShortenerClient.UpdateUtmTemplate(r.Context(), grpcReq)
+		if err != nil {
+			grpcError, _ := status.FromError(err)
+			http.Error(w, grpcError.Message(), httpStatus(grpcError.Code()))
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(ResponseUpdateTemplate{
+			Template:     toTemplate(grpcResp.Template),
+			UpdatedLinks: grpcResp.UpdatedLinks,
+		})
+	}
+}
+
+// NewDeleteTemplate deletes one of the authenticated user's UTM templates.
+func NewDeleteTemplate(client *clientConn.ClientConn) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, ok := r.Context().Value(middleware.UserIDKey).(int64)
+		if !ok {
+			http.Error(w, "User ID not found in context", http.StatusInternalServerError)
+			return
+		}
+
+		grpcReq := &us.DeleteUtmTemplateRequest{Id: chi.URLParam(r, "id"), UserId: userID}
+		if _, err := client.UrlShortenerClient.DeleteUtmTemplate(r.Context(), grpcReq); err != nil {
+			grpcError, _ := status.FromError(err)
+			http.Error(w, grpcError.Message(), httpStatus(grpcError.Code()))
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func toTemplate(t *us.UtmTemplate) UtmTemplate {
+	return UtmTemplate{
+		Id:        t.Id,
+		Name:      t.Name,
+		Source:    t.Source,
+		Medium:    t.Medium,
+		Campaign:  t.Campaign,
+		Term:      t.Term,
+		Content:   t.Content,
+		CreatedAt: t.CreatedAt.AsTime(),
+		UpdatedAt: t.UpdatedAt.AsTime(),
+	}
+}
@@ -3,18 +3,123 @@ package urls
 import (
 	clientConn "apiGW/internal/http-server/client"
 	"apiGW/internal/http-server/middleware"
+	"apiGW/internal/http-server/pages"
 	"context"
+	"crypto/rand"
+	"encoding/hex"
 	"encoding/json"
 	"github.com/go-chi/chi/v5"
 	an "github.com/yberikov/us-protos/gen/analytics-microservice"
 	us "github.com/yberikov/us-protos/gen/us-microservice"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
-	"log"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"log/slog"
 	"net/http"
+	"strings"
+	"time"
 )
 
+// Reasons of errdetails.ErrorInfo attached by us-microservice, see its grpc server package.
+const (
+	reasonNotYetActive     = "LINK_NOT_YET_ACTIVE"
+	reasonPasswordRequired = "LINK_PASSWORD_REQUIRED"
+	reasonBlocked          = "LINK_BLOCKED"
+)
+
+// Metadata keys describing the visitor of a redirect, see the grpc server package of us-microservice.
+const (
+	metadataUserAgent      = "x-visitor-user-agent"
+	metadataAcceptLanguage = "x-visitor-accept-language"
+	metadataCountry        = "x-visitor-country"
+	metadataVisitorID      = "x-visitor-id"
+)
+
+// visitorCookie holds a random id keeping the variant of split links sticky per browser.
+const visitorCookie = "vid"
+
 type RequestCreateUrl struct {
 	OriginalUrl string `json:"original_url"`
+	CustomAlias string `json:"custom_alias,omitempty"`
+	Title       string `json:"title,omitempty"`
+	// ExpiresAt is an optional RFC 3339 time after which the link stops redirecting.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	// ActiveFrom and ActiveUntil optionally restrict when the link redirects.
+	// ActiveUntil is an alternative to ExpiresAt.
+	ActiveFrom  *time.Time `json:"active_from,omitempty"`
+	ActiveUntil *time.Time `json:"active_until,omitempty"`
+	// MaxClicks optionally limits the number of redirects; 1 makes a single-use link.
+	MaxClicks int64 `json:"max_clicks,omitempty"`
+	// Password optionally protects the link with a passphrase.
+	Password string `json:"password,omitempty"`
+	// Rules optionally send some visitors elsewhere, the first matching rule wins.
+	Rules []Rule `json:"rules,omitempty"`
+	// Variants optionally split the visitors no rule matched between destinations by weight.
+	Variants []Variant `json:"variants,omitempty"`
+	// Passthrough optionally forwards the path after the alias and the query to the destination.
+	Passthrough *Passthrough `json:"passthrough,omitempty"`
+	// Redirect optionally sets the redirect status and response headers.
+	Redirect *Redirect `json:"redirect,omitempty"`
+	// UtmTemplate is the optional ID of a UTM template whose parameters tag the destinations.
+	UtmTemplate string `json:"utm_template,omitempty"`
+	// DeepLink optionally opens a mobile app first, with a web fallback.
+	DeepLink *DeepLink `json:"deep_link,omitempty"`
+	// Social optionally overrides the title, description and image shown when the link is unfurled.
+	Social *Social `json:"social,omitempty"`
+}
+
+func (req RequestCreateUrl) toProto(userID int64, ownerName string) *us.ShortenUrlRequest {
+	grpcReq := &us.ShortenUrlRequest{
+		OriginalUrl: req.OriginalUrl,
+		UserId:      userID,
+		OwnerName:   ownerName,
+		CustomAlias: req.CustomAlias,
+		Title:       req.Title,
+		MaxClicks:   req.MaxClicks,
+		Password:    req.Password,
+		UtmTemplate: req.UtmTemplate,
+	}
+	for _, r := range req.Rules {
+		grpcReq.Rules = append(grpcReq.Rules, &us.TargetRule{
+			Os:        r.OS,
+			Languages: r.Languages,
+			Countries: r.Countries,
+			UserAgent: r.UserAgent,
+			Url:       r.Url,
+		})
+	}
+	if p := req.Passthrough; p != nil {
+		grpcReq.Passthrough = &us.Passthrough{Path: p.Path, Query: p.Query, Conflict: p.Conflict}
+	}
+	if s := req.Social; s != nil {
+		grpcReq.Social = &us.SocialCard{Title: s.Title, Description: s.Description, Image: s.Image}
+	}
+	if d := req.DeepLink; d != nil {
+		grpcReq.DeepLink = &us.DeepLink{Ios: d.IOS, Android: d.Android, Fallback: d.Fallback}
+	}
+	if rd := req.Redirect; rd != nil {
+		grpcReq.Redirect = &us.RedirectOptions{
+			Status:         rd.Status,
+			CacheControl:   rd.CacheControl,
+			ReferrerPolicy: rd.ReferrerPolicy,
+			RobotsTag:      rd.RobotsTag,
+		}
+	}
+	for _, v := range req.Variants {
+		grpcReq.Variants = append(grpcReq.Variants, &us.Variant{Name: v.Name, Url: v.Url, Weight: v.Weight})
+	}
+	if req.ExpiresAt != nil {
+		grpcReq.ExpiresAt = timestamppb.New(*req.ExpiresAt)
+	}
+	if req.ActiveFrom != nil {
+		grpcReq.ActiveFrom = timestamppb.New(*req.ActiveFrom)
+	}
+	if req.ActiveUntil != nil {
+		grpcReq.ActiveUntil = timestamppb.New(*req.ActiveUntil)
+	}
+	return grpcReq
 }
 
 func NewCreateUrl(client *clientConn.ClientConn) http.HandlerFunc {
@@ -32,36 +137,177 @@ func NewCreateUrl(client *clientConn.ClientConn) http.HandlerFunc {
 			return
 		}
 
-		grpcReq := &us.ShortenUrlRequest{OriginalUrl: req.OriginalUrl, UserId: userID}
-		log.Println(grpcReq)
+		ownerName, _ := r.Context().Value(middleware.DisplayNameKey).(string)
+		grpcReq := req.toProto(userID, ownerName)
 		grpcResp, err := client.UrlShortenerClient.ShortenUrl(context.Background(), grpcReq)
 		if err != nil {
 			grpcError, _ := status.FromError(err)
-			http.Error(w, grpcError.Message(), 500)
+			http.Error(w, grpcError.Message(), httpStatus(grpcError.Code()))
 			return
 		}
-		client.Log.Info("creatingURl for:", req.OriginalUrl)
+		client.Log.Info("creating url", slog.String("url", req.OriginalUrl))
+		w.WriteHeader(http.StatusCreated)
 		json.NewEncoder(w).Encode(grpcResp)
 	}
 }
 
-func NewGetUrl(client *clientConn.ClientConn) http.HandlerFunc {
+// NewGetUrl redirects to the destination of a short link. countryHeader names
+// the request header holding the visitor's country, set by a CDN or proxy.
+// With preview=1 in the query the preview page is shown instead, and links to
+// flagged destinations always show it before the visitor moves on. Visitors
+// whose User-Agent contains one of crawlers get the social card of the link,
+// if it has one, and are not counted as clicks.
+func NewGetUrl(client *clientConn.ClientConn, countryHeader string, crawlers []string) http.HandlerFunc {
+	preview := NewPreview(client)
 
 	return func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get(previewParam) == "1" && chi.URLParam(r, "*") == "" {
+			preview(w, r)
+			return
+		}
 
 		shortUrl := chi.URLParam(r, "alias")
-		grpcReq := &us.GetOriginalUrlRequest{ShortUrl: shortUrl}
+		query, source := scanSource(r.URL.RawQuery)
+		crawler := isCrawler(r.UserAgent(), crawlers)
+		grpcReq := &us.GetOriginalUrlRequest{
+			ShortUrl: shortUrl,
+			Path:     chi.URLParam(r, "*"),
+			Query:    query,
+			Source:   source,
+			Crawler:  crawler,
+		}
 
-		grpcResp, err := client.UrlShortenerClient.GetOriginalUrl(context.Background(), grpcReq)
+		vid, fresh := visitorID(r)
+		grpcResp, err := client.UrlShortenerClient.GetOriginalUrl(visitorContext(r, countryHeader, vid), grpcReq)
 		if err != nil {
 			grpcError, _ := status.FromError(err)
-			http.Error(w, grpcError.Message(), 500)
+			if info := errorInfo(grpcError); info != nil {
+				switch info.Reason {
+				case reasonNotYetActive:
+					activeFrom, err := time.Parse(time.RFC3339, info.Metadata["active_from"])
+					if err == nil {
+						pages.NotYetActive(w, shortUrl, activeFrom)
+						return
+					}
+				case reasonPasswordRequired:
+					pages.Unlock(w, http.StatusOK, shortUrl, r.URL.RequestURI(), "")
+					return
+				case reasonBlocked:
+					pages.Blocked(w, shortUrl)
+					return
+				}
+			}
+			http.Error(w, grpcError.Message(), httpStatus(grpcError.Code()))
 			return
 		}
 
-		client.Log.Info("redirecting to", grpcResp.OriginalUrl)
+		client.Log.Info("redirecting", slog.String("url", grpcResp.OriginalUrl))
+		code := http.StatusFound
+		if rd := grpcResp.Redirect; rd != nil {
+			if rd.Status != 0 {
+				code = int(rd.Status)
+			}
+			setHeader(w, "Cache-Control", rd.CacheControl)
+			setHeader(w, "Referrer-Policy", rd.ReferrerPolicy)
+			setHeader(w, "X-Robots-Tag", rd.RobotsTag)
+		}
+		if fresh && grpcResp.Variant != "" {
+			setVisitorCookie(w, vid, code)
+		}
+		if grpcResp.Social != nil {
+			// The response depends on whether the visitor is a crawler.
+			w.Header().Add("Vary", "User-Agent")
+		}
+		// Crawlers get no destination for click-limited links.
+		if crawler && (grpcResp.Social != nil || grpcResp.OriginalUrl == "") {
+			sc := grpcResp.GetSocial()
+			pages.Social(w, sc.GetTitle(), sc.GetDescription(), sc.GetImage(), grpcResp.OriginalUrl)
+			return
+		}
+		if grpcResp.Interstitial {
+			renderPreview(w, r, client, shortUrl, grpcResp.OriginalUrl)
+			return
+		}
+		if grpcResp.AppUrl != "" {
+			pages.Handoff(w, grpcResp.AppUrl, grpcResp.OriginalUrl)
+			return
+		}
 		w.Header().Set("Location", grpcResp.OriginalUrl)
-		w.WriteHeader(http.StatusFound)
+		w.WriteHeader(code)
+	}
+}
+
+// isCrawler reports whether the User-Agent contains one of crawlers, ignoring case.
+func isCrawler(userAgent string, crawlers []string) bool {
+	userAgent = strings.ToLower(userAgent)
+	for _, c := range crawlers {
+		if c != "" && strings.Contains(userAgent, strings.ToLower(c)) {
+			return true
+		}
+	}
+	return false
+}
+
+func setHeader(w http.ResponseWriter, key, value string) {
+	if value != "" {
+		w.Header().Set(key, value)
+	}
+}
+
+// NewUnlockUrl checks the passphrase posted from the form of a protected link
+// and redirects to the destination when it matches. The form posts back to the
+// request URI of the link, so the path and query are forwarded as on a redirect.
+// Flagged destinations and deep links get the same pages as in NewGetUrl; the
+// redirect is always a 303 that is not stored, whatever the link's settings.
+func NewUnlockUrl(client *clientConn.ClientConn, countryHeader string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		shortUrl := chi.URLParam(r, "alias")
+
+		query, source := scanSource(r.URL.RawQuery)
+		grpcReq := &us.UnlockUrlRequest{
+			ShortUrl: shortUrl,
+			Password: r.PostFormValue("password"),
+			Path:     chi.URLParam(r, "*"),
+			Query:    query,
+			Source:   source,
+		}
+		vid, fresh := visitorID(r)
+		grpcResp, err := client.UrlShortenerClient.UnlockUrl(visitorContext(r, countryHeader, vid), grpcReq)
+		if err != nil {
+			grpcError, _ := status.FromError(err)
+			if info := errorInfo(grpcError); info != nil && info.Reason == reasonBlocked {
+				pages.Blocked(w, shortUrl)
+				return
+			}
+			switch grpcError.Code() {
+			case codes.PermissionDenied, codes.InvalidArgument:
+				pages.Unlock(w, http.StatusForbidden, shortUrl, r.URL.RequestURI(), "Wrong passphrase, please try again.")
+			case codes.ResourceExhausted:
+				pages.Unlock(w, http.StatusTooManyRequests, shortUrl, r.URL.RequestURI(), "Too many attempts, please wait a minute and try again.")
+			default:
+				http.Error(w, grpcError.Message(), httpStatus(grpcError.Code()))
+			}
+			return
+		}
+
+		client.Log.Info("redirecting unlocked url", slog.String("alias", shortUrl))
+		w.Header().Set("Cache-Control", "no-store")
+		if rd := grpcResp.Redirect; rd != nil {
+			setHeader(w, "Referrer-Policy", rd.ReferrerPolicy)
+			setHeader(w, "X-Robots-Tag", rd.RobotsTag)
+		}
+		if fresh && grpcResp.Variant != "" {
+			setVisitorCookie(w, vid, http.StatusSeeOther)
+		}
+		if grpcResp.Interstitial {
+			renderPreview(w, r, client, shortUrl, grpcResp.OriginalUrl)
+			return
+		}
+		if grpcResp.AppUrl != "" {
+			pages.Handoff(w, grpcResp.AppUrl, grpcResp.OriginalUrl)
+			return
+		}
+		http.Redirect(w, r, grpcResp.OriginalUrl, http.StatusSeeOther)
 	}
 }
 
@@ -87,7 +333,103 @@ func NewGetUrlStats(client *clientConn.ClientConn) http.HandlerFunc {
 			return
 		}
 
-		client.Log.Info("getting stats of:", grpcReq.Url)
+		client.Log.Info("getting stats", slog.String("url", grpcReq.Url))
 		json.NewEncoder(w).Encode(grpcResp)
 	}
 }
+
+// visitorContext forwards the attributes targeting rules and split links are
+// matched on, with id from visitorID.
+func visitorContext(r *http.Request, countryHeader, id string) context.Context {
+	pairs := []string{
+		metadataVisitorID, id,
+		metadataUserAgent, r.UserAgent(),
+		metadataAcceptLanguage, r.Header.Get("Accept-Language"),
+	}
+	if countryHeader != "" {
+		pairs = append(pairs, metadataCountry, r.Header.Get(countryHeader))
+	}
+	return metadata.AppendToOutgoingContext(r.Context(), pairs...)
+}
+
+// visitorID returns the id of the visitor cookie. Without one a new id is
+// made up and fresh is true; it is only stored with setVisitorCookie once a
+// split link needs it.
+func visitorID(r *http.Request) (id string, fresh bool) {
+	if c, err := r.Cookie(visitorCookie); err == nil && c.Value != "" {
+		return c.Value, false
+	}
+
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", false
+	}
+	return hex.EncodeToString(b), true
+}
+
+// setVisitorCookie keeps the variant picked for a new visitor sticky. The
+// cookie is left out of responses shared caches may store, so that one
+// visitor's id is never handed to others.
+func setVisitorCookie(w http.ResponseWriter, id string, code int) {
+	if sharedCacheable(w.Header(), code) {
+		return
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     visitorCookie,
+		Value:    id,
+		Path:     "/",
+		MaxAge:   int((365 * 24 * time.Hour).Seconds()),
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// sharedCacheable reports whether a CDN may store a response with the given
+// headers and status: explicitly through Cache-Control, or by default for
+// permanent redirects.
+func sharedCacheable(h http.Header, code int) bool {
+	cc := strings.ToLower(h.Get("Cache-Control"))
+	switch {
+	case strings.Contains(cc, "no-store"), strings.Contains(cc, "private"):
+		return false
+	case strings.Contains(cc, "public"), strings.Contains(cc, "max-age"):
+		return true
+	default:
+		return code == http.StatusMovedPermanently || code == http.StatusPermanentRedirect
+	}
+}
+
+// errorInfo returns the errdetails.ErrorInfo attached to a status, if any.
+func errorInfo(st *status.Status) *errdetails.ErrorInfo {
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			return info
+		}
+	}
+	return nil
+}
+
+// httpStatus maps a gRPC status code returned by a backend service to the HTTP status sent to the client.
+func httpStatus(code codes.Code) int {
+	switch code {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists:
+		return http.StatusConflict
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.FailedPrecondition:
+		// The link exists but has been disabled by its owner, has expired or used up its clicks.
+		return http.StatusGone
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,95 @@
+package urls
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func TestSetVisitorCookie(t *testing.T) {
+	tests := []struct {
+		name         string
+		cacheControl string
+		code         int
+		want         bool
+	}{
+		{"temporary redirect", "", http.StatusFound, true},
+		{"see other", "", http.StatusSeeOther, true},
+		{"permanent redirect", "", http.StatusMovedPermanently, false},
+		{"permanent redirect, private", "private, max-age=60", http.StatusPermanentRedirect, true},
+		{"no-store", "no-store", http.StatusMovedPermanently, true},
+		{"public", "public", http.StatusFound, false},
+		{"max-age", "Max-Age=300", http.StatusTemporaryRedirect, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			if tt.cacheControl != "" {
+				w.Header().Set("Cache-Control", tt.cacheControl)
+			}
+
+			setVisitorCookie(w, "abc", tt.code)
+
+			if got := len(w.Result().Cookies()) == 1; got != tt.want {
+				t.Errorf("cookie set = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVisitorID(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, "/abc", nil)
+	id, fresh := visitorID(r)
+	if id == "" || !fresh {
+		t.Errorf("visitorID() without cookie = %q, %v; want a fresh id", id, fresh)
+	}
+
+	r.AddCookie(&http.Cookie{Name: visitorCookie, Value: "known"})
+	if id, fresh := visitorID(r); id != "known" || fresh {
+		t.Errorf("visitorID() = %q, %v; want %q, false", id, fresh, "known")
+	}
+}
+
+func TestIsCrawler(t *testing.T) {
+	crawlers := []string{"facebookexternalhit", "Twitterbot", ""}
+
+	tests := []struct {
+		ua   string
+		want bool
+	}{
+		{"facebookexternalhit/1.1 (+http://www.facebook.com/externalhit_uatext.php)", true},
+		{"Mozilla/5.0 (compatible; twitterbot/1.0)", true},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64)", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		if got := isCrawler(tt.ua, crawlers); got != tt.want {
+			t.Errorf("isCrawler(%q) = %v, want %v", tt.ua, got, tt.want)
+		}
+	}
+}
+
+func TestHTTPStatus(t *testing.T) {
+	tests := []struct {
+		code codes.Code
+		want int
+	}{
+		{codes.InvalidArgument, http.StatusBadRequest},
+		{codes.Unauthenticated, http.StatusUnauthorized},
+		{codes.PermissionDenied, http.StatusForbidden},
+		{codes.NotFound, http.StatusNotFound},
+		{codes.AlreadyExists, http.StatusConflict},
+		{codes.FailedPrecondition, http.StatusGone},
+		{codes.ResourceExhausted, http.StatusTooManyRequests},
+		{codes.Unavailable, http.StatusServiceUnavailable},
+		{codes.Internal, http.StatusInternalServerError},
+		{codes.Unknown, http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		if got := httpStatus(tt.code); got != tt.want {
+			t.Errorf("httpStatus(%s) = %d, want %d", tt.code, got, tt.want)
+		}
+	}
+}
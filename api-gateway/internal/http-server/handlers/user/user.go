@@ -6,12 +6,15 @@ import (
 	"encoding/json"
 	au "github.com/yberikov/us-protos/gen/auth-microservice"
 	"google.golang.org/grpc/status"
+	"log/slog"
 	"net/http"
 )
 
 type Request struct {
 	Email    string `json:"email"`
 	Password string `json:"password"`
+	// DisplayName is optional on registration and shown on the previews of the user's links.
+	DisplayName string `json:"display_name,omitempty"`
 }
 
 func NewLogin(client *clientConn.ClientConn) http.HandlerFunc {
@@ -29,7 +32,7 @@ func NewLogin(client *clientConn.ClientConn) http.HandlerFunc {
 			http.Error(w, grpcError.Message(), 500)
 			return
 		}
-		client.Log.Info("login of user", grpcReq.Email)
+		client.Log.Info("login of user", slog.String("email", grpcReq.Email))
 		json.NewEncoder(w).Encode(grpcResp)
 	}
 }
@@ -42,7 +45,7 @@ func NewRegister(client *clientConn.ClientConn) http.HandlerFunc {
 			http.Error(w, "Invalid request payload", http.StatusBadRequest)
 			return
 		}
-		grpcReq := &au.RegisterRequest{Email: req.Email, Password: req.Password}
+		grpcReq := &au.RegisterRequest{Email: req.Email, Password: req.Password, DisplayName: req.DisplayName}
 
 		grpcResp, err := client.AuthClient.Register(context.Background(), grpcReq)
 		if err != nil {
@@ -50,7 +53,7 @@ func NewRegister(client *clientConn.ClientConn) http.HandlerFunc {
 			http.Error(w, grpcError.Message(), 500)
 			return
 		}
-		client.Log.Info("registered new user", grpcReq.Email)
+		client.Log.Info("registered new user", slog.String("email", grpcReq.Email))
 		json.NewEncoder(w).Encode(grpcResp)
 	}
 }
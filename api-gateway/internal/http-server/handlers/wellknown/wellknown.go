@@ -0,0 +1,84 @@
+// Package wellknown serves the files that let mobile apps open short links
+// directly: apple-app-site-association for iOS universal links and
+// assetlinks.json for Android app links.
+package wellknown
+
+import (
+	"apiGW/internal/config"
+	"encoding/json"
+	"net/http"
+)
+
+type appleAssociation struct {
+	AppLinks appleAppLinks `json:"applinks"`
+}
+
+type appleAppLinks struct {
+	Details []appleDetail `json:"details"`
+}
+
+type appleDetail struct {
+	AppIDs     []string         `json:"appIDs"`
+	Components []appleComponent `json:"components"`
+}
+
+type appleComponent struct {
+	Path string `json:"/"`
+}
+
+type assetLink struct {
+	Relation []string        `json:"relation"`
+	Target   assetLinkTarget `json:"target"`
+}
+
+type assetLinkTarget struct {
+	Namespace    string   `json:"namespace"`
+	PackageName  string   `json:"package_name"`
+	Fingerprints []string `json:"sha256_cert_fingerprints"`
+}
+
+// NewAppleAppSiteAssociation serves /.well-known/apple-app-site-association,
+// or 404 when no iOS app is configured.
+func NewAppleAppSiteAssociation(apps config.Apps) http.HandlerFunc {
+	if len(apps.IOSAppIDs) == 0 {
+		return http.NotFound
+	}
+
+	detail := appleDetail{AppIDs: apps.IOSAppIDs}
+	for _, p := range apps.IOSPaths {
+		detail.Components = append(detail.Components, appleComponent{Path: p})
+	}
+
+	return serveJSON(appleAssociation{AppLinks: appleAppLinks{Details: []appleDetail{detail}}})
+}
+
+// NewAssetLinks serves /.well-known/assetlinks.json, or 404 when no Android app is configured.
+func NewAssetLinks(apps config.Apps) http.HandlerFunc {
+	if apps.AndroidPackage == "" {
+		return http.NotFound
+	}
+
+	return serveJSON([]assetLink{{
+		Relation: []string{"delegate_permission/common.handle_all_urls"},
+		Target: assetLinkTarget{
+			Namespace:    "android_app",
+			PackageName:  apps.AndroidPackage,
+			Fingerprints: apps.AndroidFingerprints,
+		},
+	}})
+}
+
+// serveJSON encodes v once and serves it; both files must be served as
+// application/json without redirects.
+func serveJSON(v any) http.HandlerFunc {
+	body, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "public, max-age=3600")
+		w.Write(body)
+	}
+}
@@ -10,7 +10,11 @@ import (
 
 type contextKey string
 
-const UserIDKey contextKey = "userID"
+const (
+	UserIDKey contextKey = "userID"
+	// DisplayNameKey holds the display name of the user, possibly empty.
+	DisplayNameKey contextKey = "displayName"
+)
 
 func JwtMiddleware(client *clientConn.ClientConn) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
@@ -32,6 +36,7 @@ func JwtMiddleware(client *clientConn.ClientConn) func(http.Handler) http.Handle
 			}
 
 			ctx := context.WithValue(r.Context(), UserIDKey, grpcResp.UserId)
+			ctx = context.WithValue(ctx, DisplayNameKey, grpcResp.DisplayName)
 			// Token is valid, proceed with the request
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
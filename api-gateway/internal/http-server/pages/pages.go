@@ -0,0 +1,239 @@
+package pages
+
+import (
+	"html/template"
+	"net/http"
+	"time"
+)
+
+var notYetActive = template.Must(template.New("notYetActive").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>Link not active yet</title>
+</head>
+<body>
+<h1>This link is not active yet</h1>
+<p>The short link <strong>/{{.Alias}}</strong> becomes available on
+<time datetime="{{.ActiveFrom.Format "2006-01-02T15:04:05Z07:00"}}">{{.ActiveFrom.Format "Mon, 02 Jan 2006 15:04 MST"}}</time>.</p>
+<p>Please come back later.</p>
+</body>
+</html>
+`))
+
+var unlock = template.Must(template.New("unlock").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<meta name="robots" content="noindex">
+<title>Protected link</title>
+</head>
+<body>
+<h1>This link is protected</h1>
+<p>Enter the passphrase to continue to <strong>/{{.Alias}}</strong>.</p>
+{{if .Message}}<p role="alert">{{.Message}}</p>{{end}}
+<form method="post" action="{{.Action}}">
+<input type="password" name="password" autocomplete="off" required autofocus>
+<button type="submit">Continue</button>
+</form>
+</body>
+</html>
+`))
+
+var blocked = template.Must(template.New("blocked").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<meta name="robots" content="noindex">
+<title>Link blocked</title>
+</head>
+<body>
+<h1>This link has been blocked</h1>
+<p>The short link <strong>/{{.Alias}}</strong> points to a site that has been reported for spam, phishing or malware,
+so we no longer redirect to it.</p>
+<p>If you were asked to log in or enter payment details there, do not.</p>
+</body>
+</html>
+`))
+
+var handoff = template.Must(template.New("handoff").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<meta name="robots" content="noindex">
+<title>Opening the app</title>
+</head>
+<body>
+<h1>Opening the app…</h1>
+<p><a href="{{.App}}">Open in the app</a> or <a href="{{.Fallback}}">continue to the website</a>.</p>
+<script>
+(function () {
+  var fallback = {{.Fallback}};
+  var timer = setTimeout(function () { window.location.replace(fallback); }, 1500);
+  document.addEventListener("visibilitychange", function () {
+    if (document.hidden) {
+      clearTimeout(timer);
+    }
+  });
+  window.location.href = {{.App}};
+})();
+</script>
+</body>
+</html>
+`))
+
+var preview = template.Must(template.New("preview").Funcs(template.FuncMap{
+	"statusText": func(s string) string { return statusTexts[s] },
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<meta name="robots" content="noindex">
+<title>Link preview</title>
+</head>
+<body>
+{{if .Flagged}}
+<h1>Check this link before you continue</h1>
+<p role="alert">The destination of <strong>/{{.Alias}}</strong> has been reported as suspicious.
+Only continue if you trust it, and do not enter passwords or payment details there.</p>
+{{else}}
+<h1>Where /{{.Alias}} leads</h1>
+{{end}}
+<dl>
+{{if .Title}}<dt>Title</dt><dd>{{.Title}}</dd>{{end}}
+<dt>Destination</dt>
+<dd>{{if .Destination}}<code>{{.Destination}}</code>{{else if .Protected}}Hidden, the link is password protected{{else}}Hidden{{end}}
+{{- if .Varies}} (some visitors are sent elsewhere){{end}}</dd>
+{{if .PageTitle}}<dt>Page</dt>
+<dd>{{if and .Favicon (not .Flagged)}}<img src="{{.Favicon}}" alt="" width="16" height="16" referrerpolicy="no-referrer"> {{end}}{{.PageTitle}}
+{{- if .PageDescription}}<br>{{.PageDescription}}{{end}}</dd>{{end}}
+{{if .Owner}}<dt>Created by</dt><dd>{{.Owner}}</dd>{{end}}
+<dt>Created</dt>
+<dd><time datetime="{{.CreatedAt.Format "2006-01-02T15:04:05Z07:00"}}">{{.CreatedAt.Format "Mon, 02 Jan 2006 15:04 MST"}}</time></dd>
+{{with .Clicks}}<dt>Clicks</dt><dd>{{.}}</dd>{{end}}
+<dt>Status</dt><dd>{{statusText .Status}}</dd>
+</dl>
+{{if .Continue}}<p><a href="{{.Continue}}" rel="noreferrer">Continue</a></p>{{end}}
+</body>
+</html>
+`))
+
+// statusTexts describes the link statuses reported by us-microservice.
+var statusTexts = map[string]string{
+	"active":         "Active",
+	"disabled":       "Disabled by its owner",
+	"not_yet_active": "Not active yet",
+	"expired":        "Expired",
+	"blocked":        "Blocked, the destination was reported for spam, phishing or malware",
+}
+
+// LinkPreview is what the preview page shows about a link.
+type LinkPreview struct {
+	Alias string
+	// Destination is empty when it is hidden from visitors.
+	Destination string
+	Title       string
+	Owner       string
+	CreatedAt   time.Time
+	Status      string
+	Flagged     bool
+	Protected   bool
+	Varies      bool
+	// PageTitle, PageDescription and Favicon describe the destination page, if
+	// known. The favicon of flagged destinations is not loaded.
+	PageTitle       string
+	PageDescription string
+	Favicon         string
+	// Clicks is nil when the count is unavailable.
+	Clicks *int64
+	// Continue is where the visitor goes on; the page has no link onwards when empty.
+	Continue string
+}
+
+// Preview renders the preview page of a link, also used as the interstitial of flagged links.
+func Preview(w http.ResponseWriter, p LinkPreview) {
+	p.CreatedAt = p.CreatedAt.UTC()
+	render(w, http.StatusOK, preview, p)
+}
+
+var social = template.Must(template.New("social").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<meta property="og:type" content="website">
+{{if .Title}}<meta property="og:title" content="{{.Title}}">
+<meta name="twitter:title" content="{{.Title}}">
+{{end}}{{if .Description}}<meta name="description" content="{{.Description}}">
+<meta property="og:description" content="{{.Description}}">
+<meta name="twitter:description" content="{{.Description}}">
+{{end}}{{if .Image}}<meta property="og:image" content="{{.Image}}">
+<meta name="twitter:image" content="{{.Image}}">
+<meta name="twitter:card" content="summary_large_image">
+{{else}}<meta name="twitter:card" content="summary">
+{{end}}</head>
+<body>
+{{if .Destination}}<p><a href="{{.Destination}}">{{if .Title}}{{.Title}}{{else}}{{.Destination}}{{end}}</a></p>
+{{else if .Title}}<p>{{.Title}}</p>
+{{end}}</body>
+</html>
+`))
+
+// Unlock renders the passphrase form of a protected link, with an optional
+// error message. The form posts to action, the request URI of the link, so the
+// path and query forwarded by passthrough links survive the unlock.
+func Unlock(w http.ResponseWriter, code int, alias, action, message string) {
+	render(w, code, unlock, struct {
+		Alias   string
+		Action  string
+		Message string
+	}{alias, action, message})
+}
+
+// NotYetActive renders a 404 page telling the visitor when the link opens.
+func NotYetActive(w http.ResponseWriter, alias string, activeFrom time.Time) {
+	render(w, http.StatusNotFound, notYetActive, struct {
+		Alias      string
+		ActiveFrom time.Time
+	}{alias, activeFrom.UTC()})
+}
+
+// Blocked renders a 403 warning page instead of redirecting to a blocked destination.
+func Blocked(w http.ResponseWriter, alias string) {
+	render(w, http.StatusForbidden, blocked, struct {
+		Alias string
+	}{alias})
+}
+
+// Handoff renders a page that tries to open the app URI and falls back to the
+// web URL unless the page gets hidden, which means the app opened. appURL must have been validated, custom
+// schemes are trusted as is.
+func Handoff(w http.ResponseWriter, appURL, fallback string) {
+	render(w, http.StatusOK, handoff, struct {
+		App      template.URL
+		Fallback string
+	}{template.URL(appURL), fallback})
+}
+
+// Social renders the Open Graph and Twitter card tags of a link for crawlers
+// unfurling it, with a plain link to the destination unless it is empty.
+func Social(w http.ResponseWriter, title, description, image, destination string) {
+	render(w, http.StatusOK, social, struct {
+		Title       string
+		Description string
+		Image       string
+		Destination string
+	}{title, description, image, destination})
+}
+
+func render(w http.ResponseWriter, code int, tmpl *template.Template, data any) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	tmpl.Execute(w, data)
+}
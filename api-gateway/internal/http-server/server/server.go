@@ -5,9 +5,16 @@ import (
 	clientConn "apiGW/internal/http-server/client"
 	"apiGW/internal/http-server/handlers/urls"
 	"apiGW/internal/http-server/handlers/user"
+	"apiGW/internal/http-server/handlers/wellknown"
 	"apiGW/internal/http-server/middleware"
+	"apiGW/internal/qr"
+	"fmt"
 	"github.com/go-chi/chi/v5"
+	"github.com/yberikov/us-protos/routes"
+	"image"
 	"net/http"
+	"slices"
+	"strings"
 )
 
 func New(cfg *config.Config, client *clientConn.ClientConn) *http.Server {
@@ -18,11 +25,32 @@ func New(cfg *config.Config, client *clientConn.ClientConn) *http.Server {
 
 		r.HandleFunc("/createUrl", urls.NewCreateUrl(client))
 		r.HandleFunc("/getUrlStats", urls.NewGetUrlStats(client))
+		r.Get("/links", urls.NewListLinks(client))
+		r.Post("/links:batch", urls.NewCreateUrls(client))
+		r.Patch("/links/{alias}", urls.NewUpdateLink(client))
+		r.Delete("/links/{alias}", urls.NewDeleteLink(client))
+		r.Post("/links/{alias}/disable", urls.NewDisableLink(client, false))
+		r.Post("/links/{alias}/enable", urls.NewDisableLink(client, true))
+		r.Get("/links/{alias}/health", urls.NewGetLinkHealth(client))
+		r.Get("/utm-templates", urls.NewListTemplates(client))
+		r.Post("/utm-templates", urls.NewCreateTemplate(client))
+		r.Get("/utm-templates/{id}", urls.NewGetTemplate(client))
+		r.Put("/utm-templates/{id}", urls.NewUpdateTemplate(client))
+		r.Delete("/utm-templates/{id}", urls.NewDeleteTemplate(client))
 	})
 
 	router.HandleFunc("/login", user.NewLogin(client))
 	router.HandleFunc("/register", user.NewRegister(client))
-	router.HandleFunc("/{alias}", urls.NewGetUrl(client))
+	router.Get("/.well-known/apple-app-site-association", wellknown.NewAppleAppSiteAssociation(cfg.Apps))
+	router.Get("/.well-known/assetlinks.json", wellknown.NewAssetLinks(cfg.Apps))
+	router.HandleFunc("/{alias}", urls.NewGetUrl(client, cfg.CountryHeader, cfg.Crawlers))
+	router.Get("/{alias}+", urls.NewPreview(client))
+	router.Get("/{alias}/qr", urls.NewGetQR(client, cfg.BaseURL, mustLoadLogo(cfg.QR.LogoPath)))
+	router.HandleFunc("/{alias}/*", urls.NewGetUrl(client, cfg.CountryHeader, cfg.Crawlers))
+	router.Post("/{alias}", urls.NewUnlockUrl(client, cfg.CountryHeader))
+	router.Post("/{alias}/*", urls.NewUnlockUrl(client, cfg.CountryHeader))
+
+	mustReserveRoutes(router)
 
 	return &http.Server{
 		Addr:         ":" + cfg.Port,
@@ -31,3 +59,34 @@ func New(cfg *config.Config, client *clientConn.ClientConn) *http.Server {
 		WriteTimeout: cfg.Timeout,
 	}
 }
+
+// mustLoadLogo loads the QR code logo, nil when none is configured.
+func mustLoadLogo(path string) image.Image {
+	if path == "" {
+		return nil
+	}
+	logo, err := qr.LoadLogo(path)
+	if err != nil {
+		panic("cannot load QR logo: " + err.Error())
+	}
+	return logo
+}
+
+// mustReserveRoutes panics when a fixed top-level route is missing from
+// routes.Gateway, since us-microservice could then hand its path out as an alias.
+func mustReserveRoutes(router chi.Routes) {
+	err := chi.Walk(router, func(method, route string, _ http.Handler, _ ...func(http.Handler) http.Handler) error {
+		segment, _, _ := strings.Cut(strings.TrimPrefix(route, "/"), "/")
+		segment, _, _ = strings.Cut(segment, ":")
+		if segment == "" || strings.HasPrefix(segment, "{") {
+			return nil
+		}
+		if !slices.Contains(routes.Gateway, segment) {
+			return fmt.Errorf("route %s %s: %q is not listed in routes.Gateway", method, route, segment)
+		}
+		return nil
+	})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,236 @@
+// Package qr renders QR codes as PNG or SVG images.
+package qr
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/jpeg"
+	"image/png"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/skip2/go-qrcode"
+	"golang.org/x/image/draw"
+)
+
+var ErrTooSmall = errors.New("size is too small for the content")
+
+// Options controls how a code is drawn.
+type Options struct {
+	// Size is the width and height of the image, in pixels for PNG and user units for SVG.
+	Size int
+	// Level is the error correction level. It is raised to qrcode.High when a logo is drawn.
+	Level qrcode.RecoveryLevel
+	// Margin is the width of the quiet zone around the code, in modules.
+	Margin     int
+	Foreground color.NRGBA
+	Background color.NRGBA
+	// Logo, if set, is drawn over the center of the code.
+	Logo image.Image
+}
+
+// logoShare is the part of the code width the logo may cover. It stays well
+// below what qrcode.High can recover.
+const logoShare = 0.22
+
+// code is an encoded QR code with the area covered by the logo cleared.
+type code struct {
+	modules [][]bool
+	// logo is the square covered by the logo, in modules including the margin.
+	logo image.Rectangle
+}
+
+func encode(content string, opts Options) (*code, error) {
+	level := opts.Level
+	if opts.Logo != nil && level < qrcode.High {
+		level = qrcode.High
+	}
+
+	q, err := qrcode.New(content, level)
+	if err != nil {
+		return nil, err
+	}
+	q.DisableBorder = true
+	bitmap := q.Bitmap()
+
+	n := len(bitmap) + 2*opts.Margin
+	c := &code{modules: make([][]bool, n)}
+	for y := range c.modules {
+		c.modules[y] = make([]bool, n)
+	}
+	for y, row := range bitmap {
+		copy(c.modules[y+opts.Margin][opts.Margin:], row)
+	}
+
+	if opts.Logo != nil {
+		side := int(float64(len(bitmap)) * logoShare)
+		// Keep the logo centered on the center module.
+		if side%2 != len(bitmap)%2 {
+			side++
+		}
+		start := (n - side) / 2
+		c.logo = image.Rect(start, start, start+side, start+side)
+		for y := c.logo.Min.Y; y < c.logo.Max.Y; y++ {
+			for x := c.logo.Min.X; x < c.logo.Max.X; x++ {
+				c.modules[y][x] = false
+			}
+		}
+	}
+
+	return c, nil
+}
+
+// PNG writes the code of content as a PNG image.
+func PNG(w io.Writer, content string, opts Options) error {
+	c, err := encode(content, opts)
+	if err != nil {
+		return err
+	}
+
+	n := len(c.modules)
+	scale := opts.Size / n
+	if scale < 1 {
+		return ErrTooSmall
+	}
+	// The modules are whole pixels; what is left over pads the margin evenly.
+	offset := (opts.Size - scale*n) / 2
+
+	img := image.NewNRGBA(image.Rect(0, 0, opts.Size, opts.Size))
+	draw.Draw(img, img.Bounds(), image.NewUniform(opts.Background), image.Point{}, draw.Src)
+	fg := image.NewUniform(opts.Foreground)
+	for y, row := range c.modules {
+		for x, dark := range row {
+			if dark {
+				r := image.Rect(offset+x*scale, offset+y*scale, offset+(x+1)*scale, offset+(y+1)*scale)
+				draw.Draw(img, r, fg, image.Point{}, draw.Src)
+			}
+		}
+	}
+
+	if opts.Logo != nil {
+		box := image.Rect(
+			offset+c.logo.Min.X*scale, offset+c.logo.Min.Y*scale,
+			offset+c.logo.Max.X*scale, offset+c.logo.Max.Y*scale,
+		)
+		draw.ApproxBiLinear.Scale(img, fit(box, opts.Logo.Bounds()), opts.Logo, opts.Logo.Bounds(), draw.Over, nil)
+	}
+
+	return png.Encode(w, img)
+}
+
+// SVG writes the code of content as an SVG image drawn in module units.
+func SVG(w io.Writer, content string, opts Options) error {
+	c, err := encode(content, opts)
+	if err != nil {
+		return err
+	}
+
+	n := len(c.modules)
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" shape-rendering="crispEdges">`,
+		opts.Size, opts.Size, n, n)
+	fmt.Fprintf(&b, `<rect width="%d" height="%d"%s/>`, n, n, fill(opts.Background))
+
+	// One path for all dark modules, horizontal runs merged.
+	b.WriteString(`<path d="`)
+	for y, row := range c.modules {
+		for x := 0; x < n; x++ {
+			if !row[x] {
+				continue
+			}
+			start := x
+			for x < n && row[x] {
+				x++
+			}
+			fmt.Fprintf(&b, "M%d %dh%dv1h-%dz", start, y, x-start, x-start)
+		}
+	}
+	fmt.Fprintf(&b, `"%s/>`, fill(opts.Foreground))
+
+	if opts.Logo != nil {
+		var logo bytes.Buffer
+		if err := png.Encode(&logo, opts.Logo); err != nil {
+			return err
+		}
+		box := c.logo
+		fmt.Fprintf(&b, `<image x="%d" y="%d" width="%d" height="%d" preserveAspectRatio="xMidYMid meet" href="data:image/png;base64,%s"/>`,
+			box.Min.X, box.Min.Y, box.Dx(), box.Dy(), base64.StdEncoding.EncodeToString(logo.Bytes()))
+	}
+	b.WriteString(`</svg>`)
+
+	_, err = io.WriteString(w, b.String())
+	return err
+}
+
+// LoadLogo reads a PNG or JPEG logo.
+func LoadLogo(path string) (image.Image, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	logo, _, err := image.Decode(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return logo, nil
+}
+
+// ParseColor parses a hex color: RGB, RRGGBB or RRGGBBAA, with an optional leading '#'.
+func ParseColor(raw string) (color.NRGBA, error) {
+	s := strings.TrimPrefix(raw, "#")
+	if len(s) == 3 {
+		s = string([]byte{s[0], s[0], s[1], s[1], s[2], s[2]})
+	}
+	if len(s) == 6 {
+		s += "ff"
+	}
+	v, err := strconv.ParseUint(s, 16, 32)
+	if len(s) != 8 || err != nil {
+		return color.NRGBA{}, fmt.Errorf("invalid color %q", raw)
+	}
+	return color.NRGBA{R: uint8(v >> 24), G: uint8(v >> 16), B: uint8(v >> 8), A: uint8(v)}, nil
+}
+
+// ParseLevel parses an error correction level: L, M, Q or H.
+func ParseLevel(s string) (qrcode.RecoveryLevel, error) {
+	switch strings.ToUpper(s) {
+	case "L":
+		return qrcode.Low, nil
+	case "M":
+		return qrcode.Medium, nil
+	case "Q":
+		return qrcode.High, nil
+	case "H":
+		return qrcode.Highest, nil
+	default:
+		return 0, fmt.Errorf("invalid error correction level %q", s)
+	}
+}
+
+func fill(c color.NRGBA) string {
+	attr := fmt.Sprintf(` fill="#%02x%02x%02x"`, c.R, c.G, c.B)
+	if c.A != 0xff {
+		attr += fmt.Sprintf(` fill-opacity="%.3g"`, float64(c.A)/0xff)
+	}
+	return attr
+}
+
+// fit returns the largest rectangle with the aspect ratio of src centered in box.
+func fit(box, src image.Rectangle) image.Rectangle {
+	w, h := box.Dx(), box.Dy()
+	if src.Dx()*h > src.Dy()*w {
+		h = src.Dy() * w / src.Dx()
+	} else {
+		w = src.Dx() * h / src.Dy()
+	}
+	origin := box.Min.Add(image.Pt((box.Dx()-w)/2, (box.Dy()-h)/2))
+	return image.Rectangle{Min: origin, Max: origin.Add(image.Pt(w, h))}
+}
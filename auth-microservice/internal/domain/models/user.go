@@ -4,4 +4,6 @@ type User struct {
 	ID       int64
 	Email    string
 	PassHash []byte
+	// DisplayName is shown to visitors instead of the email; it may be empty.
+	DisplayName string
 }
@@ -10,10 +10,16 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
+// maxDisplayName bounds the length of display names, in characters.
+const maxDisplayName = 50
+
 type AuthService interface {
-	SaveUser(ctx context.Context, email string, pass string) (uid int64, err error)
+	SaveUser(ctx context.Context, email, displayName, pass string) (uid int64, err error)
 	GetUser(ctx context.Context, email string) (models.User, error)
 	GenerateJWT(ctx context.Context, user models.User) (string, error)
 	ValidateJWT(ctx context.Context, token string) (models.User, error)
@@ -35,10 +41,14 @@ func (s *serverAPI) Register(
 	if in.Email == "" || in.Password == "" {
 		return nil, status.Error(codes.InvalidArgument, "email and password are required")
 	}
+	displayName := strings.TrimSpace(in.DisplayName)
+	if utf8.RuneCountInString(displayName) > maxDisplayName || strings.ContainsFunc(displayName, unicode.IsControl) {
+		return nil, status.Errorf(codes.InvalidArgument, "display_name must be up to %d printable characters", maxDisplayName)
+	}
 
 	// Save the user
 
-	userID, err := s.authService.SaveUser(ctx, in.Email, in.Password)
+	userID, err := s.authService.SaveUser(ctx, in.Email, displayName, in.Password)
 	if err != nil {
 		if errors.Is(err, storage.ErrUserExists) {
 			return nil, status.Error(codes.AlreadyExists, "user already exists")
@@ -93,7 +103,8 @@ func (s *serverAPI) ValidateToken(
 	}
 
 	return &pb.ValidateTokenResponse{
-		Email:  user.Email,
-		UserId: user.ID,
+		Email:       user.Email,
+		UserId:      user.ID,
+		DisplayName: user.DisplayName,
 	}, nil
 }
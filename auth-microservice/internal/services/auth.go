@@ -11,7 +11,7 @@ import (
 )
 
 type UserStorage interface {
-	SaveUser(ctx context.Context, email string, passHash []byte) (uid int64, err error)
+	SaveUser(ctx context.Context, email, displayName string, passHash []byte) (uid int64, err error)
 	GetUser(ctx context.Context, email string) (models.User, error)
 }
 
@@ -29,7 +29,7 @@ func New(log *slog.Logger,
 	}
 }
 
-func (u *Auth) SaveUser(ctx context.Context, email string, pass string) (uid int64, err error) {
+func (u *Auth) SaveUser(ctx context.Context, email, displayName, pass string) (uid int64, err error) {
 	u.log.Info("saveUSER service", email, pass)
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(pass), bcrypt.DefaultCost)
 	if err != nil {
@@ -38,7 +38,7 @@ func (u *Auth) SaveUser(ctx context.Context, email string, pass string) (uid int
 	}
 
 	// Save the user
-	userID, err := u.storage.SaveUser(ctx, email, hashedPassword)
+	userID, err := u.storage.SaveUser(ctx, email, displayName, hashedPassword)
 	if err != nil {
 		u.log.Error("failed to save user", slog.String("err", err.Error()))
 		return 0, err
@@ -25,6 +25,8 @@ type UserDocument struct {
 	ID       int64  `bson:"_id"`
 	Email    string `bson:"email"`
 	Password string `bson:"password"`
+
+	DisplayName string `bson:"display_name,omitempty"`
 }
 
 type Counter struct {
@@ -79,7 +81,7 @@ func (s *Storage) getNextSequence(ctx context.Context, name string) (int64, erro
 	return counter.Seq, nil
 }
 
-func (s *Storage) SaveUser(ctx context.Context, email string, passHash []byte) (int64, error) {
+func (s *Storage) SaveUser(ctx context.Context, email, displayName string, passHash []byte) (int64, error) {
 
 	userID, err := s.getNextSequence(ctx, "userid")
 
@@ -91,6 +93,8 @@ func (s *Storage) SaveUser(ctx context.Context, email string, passHash []byte) (
 		ID:       userID,
 		Email:    email,
 		Password: string(passHash),
+
+		DisplayName: displayName,
 	}
 
 	_, err = s.collection.InsertOne(ctx, doc)
@@ -126,5 +130,7 @@ func (s *Storage) GetUser(ctx context.Context, email string) (models.User, error
 		ID:       doc.ID,
 		Email:    doc.Email,
 		PassHash: []byte(doc.Password),
+
+		DisplayName: doc.DisplayName,
 	}, nil
 }
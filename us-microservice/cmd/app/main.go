@@ -20,6 +20,10 @@ func main() {
 	ctx, cancel := context.WithCancel(context.Background())
 
 	go application.GRPCServer.Run(ctx)
+	go application.Blocklist.Watch(ctx)
+	go application.Flaglist.Watch(ctx)
+	go application.Enricher.Run(ctx)
+	go application.Health.Run(ctx)
 
 	// Graceful shutdown
 
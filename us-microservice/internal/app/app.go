@@ -3,8 +3,12 @@ package app
 import (
 	"log/slog"
 	grpcapp "urlSh/internal/app/grpc"
+	"urlSh/internal/blocklist"
 	"urlSh/internal/config"
 	"urlSh/internal/domain/models"
+	"urlSh/internal/enrich"
+	"urlSh/internal/health"
+	"urlSh/internal/safehttp"
 	"urlSh/internal/services"
 	"urlSh/internal/storage/mongodb"
 	"urlSh/internal/storage/redis"
@@ -12,6 +16,10 @@ import (
 
 type App struct {
 	GRPCServer *grpcapp.App
+	Blocklist  *blocklist.Blocklist
+	Flaglist   *blocklist.Blocklist
+	Enricher   *enrich.Enricher
+	Health     *health.Checker
 }
 
 func New(log *slog.Logger, cfg *config.Config) *App {
@@ -25,11 +33,75 @@ func New(log *slog.Logger, cfg *config.Config) *App {
 	}
 	kafkaCh := make(chan models.Url)
 
-	urlService := services.New(log, storage, cache, cfg.Ttl, kafkaCh)
+	aliases, err := services.NewAliasGenerator(cfg.Alias.Strategy, storage, cfg.Alias.Salt)
+	if err != nil {
+		panic(err)
+	}
+
+	blocked, err := blocklist.New(log, cfg.Blocklist.Path, cfg.Blocklist.ReloadInterval)
+	if err != nil {
+		panic(err)
+	}
+
+	flagged, err := blocklist.New(log, cfg.Flaglist.Path, cfg.Flaglist.ReloadInterval)
+	if err != nil {
+		panic(err)
+	}
+
+	enricher := enrich.New(log, storage, enrich.Options{
+		Workers:      cfg.Enrich.Workers,
+		QueueSize:    cfg.Enrich.QueueSize,
+		Timeout:      cfg.Enrich.Timeout,
+		MaxBytes:     cfg.Enrich.MaxBytes,
+		MaxRedirects: cfg.Enrich.MaxRedirects,
+		UserAgent:    cfg.Enrich.UserAgent,
+	})
+
+	checker := health.New(log, storage, safehttp.NewClient(cfg.Health.Timeout, cfg.Health.MaxRedirects), health.Options{
+		Workers:     cfg.Health.Workers,
+		Interval:    cfg.Health.Interval,
+		Tick:        cfg.Health.Tick,
+		BatchSize:   cfg.Health.BatchSize,
+		Lease:       cfg.Health.Lease,
+		HostDelay:   cfg.Health.HostDelay,
+		BrokenAfter: cfg.Health.BrokenAfter,
+		UserAgent:   cfg.Health.UserAgent,
+	})
+
+	urlService := services.New(log, storage, cache, cfg.Ttl, kafkaCh, aliases, blocked, flagged, enricher, services.Options{
+		Alias: services.AliasOptions{
+			Length:        cfg.Alias.Length,
+			MaxAttempts:   cfg.Alias.MaxAttempts,
+			GrowThreshold: cfg.Alias.GrowThreshold,
+		},
+		URL: services.URLOptions{
+			Schemes:        cfg.URL.Schemes,
+			StripTracking:  cfg.URL.StripTracking,
+			TrackingParams: cfg.URL.TrackingParams,
+		},
+		Chain: services.ChainOptions{
+			ShortDomains:   cfg.Chain.ShortDomains,
+			Resolve:        cfg.Chain.Resolve,
+			MaxDepth:       cfg.Chain.MaxDepth,
+			ShortenerHosts: cfg.Chain.ShortenerHosts,
+		},
+		Reserved: services.ReservedOptions{
+			Words:           cfg.Reserved.Words,
+			FilterProfanity: cfg.Reserved.FilterProfanity,
+		},
+		DeleteQuarantine: cfg.DeleteQuarantine,
+		UnlockAttempts:   cfg.Unlock.MaxAttempts,
+		UnlockWindow:     cfg.Unlock.Window,
+		MaxBatchSize:     cfg.MaxBatchSize,
+	})
 
 	grpcApp := grpcapp.New(log, cfg, urlService, kafkaCh)
 
 	return &App{
 		GRPCServer: grpcApp,
+		Blocklist:  blocked,
+		Flaglist:   flagged,
+		Enricher:   enricher,
+		Health:     checker,
 	}
 }
@@ -11,13 +11,23 @@ import (
 	"urlSh/internal/grpc/server"
 	"urlSh/internal/kafka"
 
+	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/recovery"
+	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/selector"
+	pb "github.com/yberikov/us-protos/gen/us-microservice"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// secretMethods carry link passwords; their payloads are never logged.
+var secretMethods = map[string]bool{
+	pb.UrlShorteningService_ShortenUrl_FullMethodName:  true,
+	pb.UrlShorteningService_ShortenUrls_FullMethodName: true,
+	pb.UrlShorteningService_UnlockUrl_FullMethodName:   true,
+}
+
 type App struct {
 	log        *slog.Logger
 	config     *config.Config
@@ -37,6 +47,15 @@ func New(
 			logging.PayloadReceived, logging.PayloadSent,
 		),
 	}
+	secretLoggingOpts := []logging.Option{
+		logging.WithLogOnEvents(logging.FinishCall),
+	}
+	isSecret := selector.MatchFunc(func(_ context.Context, c interceptors.CallMeta) bool {
+		return secretMethods[c.FullMethod()]
+	})
+	notSecret := selector.MatchFunc(func(_ context.Context, c interceptors.CallMeta) bool {
+		return !secretMethods[c.FullMethod()]
+	})
 
 	recoveryOpts := []recovery.Option{
 		recovery.WithRecoveryHandler(func(p interface{}) (err error) {
@@ -47,7 +66,8 @@ func New(
 
 	gRPCServer := grpc.NewServer(grpc.ChainUnaryInterceptor(
 		recovery.UnaryServerInterceptor(recoveryOpts...),
-		logging.UnaryServerInterceptor(InterceptorLogger(log), loggingOpts...),
+		selector.UnaryServerInterceptor(logging.UnaryServerInterceptor(InterceptorLogger(log), loggingOpts...), notSecret),
+		selector.UnaryServerInterceptor(logging.UnaryServerInterceptor(InterceptorLogger(log), secretLoggingOpts...), isSecret),
 	), grpc.ConnectionTimeout(config.Grpc.Timeout))
 
 	server.Register(gRPCServer, urlService)
@@ -0,0 +1,186 @@
+// Package blocklist keeps the rules of destinations that must neither be
+// shortened nor redirected to, reloading them whenever their file changes.
+//
+// The file holds one rule per line; blank lines and lines starting with '#' are ignored:
+//
+//	evil.example              the host evil.example only (same as domain:evil.example)
+//	domain:evil.example       the host evil.example only
+//	suffix:evil.example       evil.example and all of its subdomains
+//	regex:^https?://[^/]*pay  a regular expression matched against the whole URL
+package blocklist
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"golang.org/x/net/idna"
+	"io"
+	"log/slog"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+type Blocklist struct {
+	log      *slog.Logger
+	path     string
+	interval time.Duration
+
+	rules   atomic.Pointer[rules]
+	modTime time.Time
+	size    int64
+}
+
+type rules struct {
+	domains  map[string]string
+	suffixes map[string]string
+	patterns []*regexp.Regexp
+}
+
+// New loads the rules from path. An empty path gives a blocklist that never matches.
+func New(log *slog.Logger, path string, interval time.Duration) (*Blocklist, error) {
+	const op = "blocklist.New"
+
+	b := &Blocklist{log: log, path: path, interval: interval}
+	b.rules.Store(&rules{})
+	if path == "" {
+		return b, nil
+	}
+
+	if _, err := b.reload(); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return b, nil
+}
+
+// Match reports whether the destination is blocked and by which rule.
+func (b *Blocklist) Match(rawURL string) (rule string, blocked bool) {
+	r := b.rules.Load()
+
+	if u, err := url.Parse(rawURL); err == nil {
+		host := strings.TrimSuffix(strings.ToLower(u.Hostname()), ".")
+		if rule, ok := r.domains[host]; ok {
+			return rule, true
+		}
+		for h := host; h != ""; {
+			if rule, ok := r.suffixes[h]; ok {
+				return rule, true
+			}
+			_, h, _ = strings.Cut(h, ".")
+		}
+	}
+
+	for _, p := range r.patterns {
+		if p.MatchString(rawURL) {
+			return "regex:" + p.String(), true
+		}
+	}
+
+	return "", false
+}
+
+// Watch polls the file and reloads the rules whenever its modification time
+// or size changes, until ctx is done. A file that fails to parse is logged
+// and the previous rules stay in effect.
+func (b *Blocklist) Watch(ctx context.Context) {
+	if b.path == "" || b.interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(b.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			reloaded, err := b.reload()
+			if err != nil {
+				b.log.Error("failed to reload blocklist", slog.String("path", b.path), slog.String("err", err.Error()))
+				continue
+			}
+			if reloaded {
+				b.log.Info("blocklist reloaded", slog.String("path", b.path))
+			}
+		}
+	}
+}
+
+// reload reads the file if it changed since it was last read.
+func (b *Blocklist) reload() (bool, error) {
+	info, err := os.Stat(b.path)
+	if err != nil {
+		return false, err
+	}
+	if info.ModTime().Equal(b.modTime) && info.Size() == b.size {
+		return false, nil
+	}
+
+	f, err := os.Open(b.path)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	// A broken file is reported once, not on every tick until it is fixed.
+	b.modTime, b.size = info.ModTime(), info.Size()
+
+	r, err := parse(f)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", b.path, err)
+	}
+
+	b.rules.Store(r)
+
+	return true, nil
+}
+
+func parse(in io.Reader) (*rules, error) {
+	r := &rules{domains: map[string]string{}, suffixes: map[string]string{}}
+
+	scanner := bufio.NewScanner(in)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		kind, value, found := strings.Cut(line, ":")
+		if !found {
+			kind, value = "domain", line
+		}
+		value = strings.TrimSpace(value)
+
+		switch kind {
+		case "domain", "suffix":
+			host, err := idna.Lookup.ToASCII(strings.Trim(value, "."))
+			if err != nil || host == "" {
+				return nil, fmt.Errorf("line %d: invalid host %q", n, value)
+			}
+			host = strings.ToLower(host)
+			if kind == "domain" {
+				r.domains[host] = line
+			} else {
+				r.suffixes[host] = line
+			}
+		case "regex":
+			p, err := regexp.Compile(value)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", n, err)
+			}
+			r.patterns = append(r.patterns, p)
+		default:
+			return nil, fmt.Errorf("line %d: unknown rule type %q", n, kind)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
@@ -0,0 +1,118 @@
+package blocklist
+
+import (
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testRules = `
+# comment
+evil.example
+domain:phish.example
+suffix:malware.example
+suffix:bücher.example
+regex:^https?://[^/]*pay[^/]*/login
+`
+
+func newTestBlocklist(t *testing.T, content string) (*Blocklist, string) {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "blocklist.txt")
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	b, err := New(slog.New(slog.NewTextHandler(io.Discard, nil)), path, time.Second)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	return b, path
+}
+
+func TestMatch(t *testing.T) {
+	b, _ := newTestBlocklist(t, testRules)
+
+	tests := []struct {
+		url  string
+		rule string
+	}{
+		{"https://evil.example/", "evil.example"},
+		{"https://EVIL.example./path", "evil.example"},
+		{"https://phish.example:8443/", "domain:phish.example"},
+		{"https://malware.example/", "suffix:malware.example"},
+		{"https://a.b.malware.example/x", "suffix:malware.example"},
+		{"https://xn--bcher-kva.example/", "suffix:bücher.example"},
+		{"http://mypaypal.example/login", "regex:^https?://[^/]*pay[^/]*/login"},
+
+		{"https://sub.evil.example/", ""},
+		{"https://sub.phish.example/", ""},
+		{"https://notmalware.example/", ""},
+		{"https://example.com/?next=https://evil.example/", ""},
+		{"http://mypaypal.example/logout", ""},
+	}
+	for _, tt := range tests {
+		rule, blocked := b.Match(tt.url)
+		if blocked != (tt.rule != "") || rule != tt.rule {
+			t.Errorf("Match(%q) = %q, %v; want %q", tt.url, rule, blocked, tt.rule)
+		}
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, content := range []string{
+		"unknown:evil.example",
+		"regex:(",
+		"domain:",
+		"suffix:...",
+	} {
+		if _, err := parse(strings.NewReader(content)); err == nil {
+			t.Errorf("parse(%q) succeeded, want an error", content)
+		}
+	}
+}
+
+func TestEmptyPath(t *testing.T) {
+	b, err := New(slog.New(slog.NewTextHandler(io.Discard, nil)), "", time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rule, blocked := b.Match("https://evil.example/"); blocked {
+		t.Errorf("empty blocklist matched %q", rule)
+	}
+}
+
+func TestReload(t *testing.T) {
+	b, path := newTestBlocklist(t, "evil.example\n")
+
+	if reloaded, err := b.reload(); err != nil || reloaded {
+		t.Fatalf("reload of an unchanged file = %v, %v; want false, nil", reloaded, err)
+	}
+
+	if err := os.WriteFile(path, []byte("suffix:other.example\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if reloaded, err := b.reload(); err != nil || !reloaded {
+		t.Fatalf("reload of a changed file = %v, %v; want true, nil", reloaded, err)
+	}
+	if _, blocked := b.Match("https://evil.example/"); blocked {
+		t.Error("old rule still matches after reload")
+	}
+	if _, blocked := b.Match("https://www.other.example/"); !blocked {
+		t.Error("new rule does not match after reload")
+	}
+
+	// A broken file keeps the previous rules.
+	if err := os.WriteFile(path, []byte("regex:(\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := b.reload(); err == nil {
+		t.Fatal("reload of a broken file succeeded")
+	}
+	if _, blocked := b.Match("https://www.other.example/"); !blocked {
+		t.Error("previous rules dropped after a broken reload")
+	}
+}
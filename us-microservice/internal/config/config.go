@@ -16,6 +16,100 @@ type Config struct {
 	Ttl       time.Duration `yaml:"ttl"`
 	Brokers   string        `yaml:"brokers"`
 	Topic     string        `yaml:"topic"`
+	Alias     Alias         `yaml:"alias"`
+	URL       URL           `yaml:"url"`
+	Blocklist Blocklist     `yaml:"blocklist"`
+	Flaglist  Blocklist     `yaml:"flaglist"`
+	Chain     Chain         `yaml:"chain"`
+	Reserved  Reserved      `yaml:"reserved"`
+	// DeleteQuarantine is how long the alias of a deleted link stays reserved.
+	DeleteQuarantine time.Duration `yaml:"delete_quarantine" env-default:"720h"`
+	Unlock           Unlock        `yaml:"unlock"`
+	// MaxBatchSize caps the number of links created by one batch request.
+	MaxBatchSize int    `yaml:"max_batch_size" env-default:"1000"`
+	Enrich       Enrich `yaml:"enrich"`
+	Health       Health `yaml:"health"`
+}
+
+// Health configures the periodic checks of link destinations.
+type Health struct {
+	// Workers is the number of hosts checked at the same time; zero disables checking.
+	Workers int `yaml:"workers" env-default:"8"`
+	// Interval is how often the destination of a link is checked.
+	Interval time.Duration `yaml:"interval" env-default:"6h"`
+	// Tick is how often links due for a check are looked up.
+	Tick      time.Duration `yaml:"tick" env-default:"1m"`
+	BatchSize int           `yaml:"batch_size" env-default:"500"`
+	// Lease is how long a replica keeps the links it claimed for a check to itself.
+	Lease time.Duration `yaml:"lease" env-default:"30m"`
+	// HostDelay is the pause between two requests to the same host.
+	HostDelay    time.Duration `yaml:"host_delay" env-default:"1s"`
+	Timeout      time.Duration `yaml:"timeout" env-default:"10s"`
+	MaxRedirects int           `yaml:"max_redirects" env-default:"5"`
+	// BrokenAfter is the number of failed checks in a row after which a link is flagged as broken.
+	BrokenAfter int    `yaml:"broken_after" env-default:"3"`
+	UserAgent   string `yaml:"user_agent" env-default:"urlSh-health/1.0"`
+}
+
+// Enrich configures the background fetch of destination titles, descriptions and icons.
+type Enrich struct {
+	// Workers is the number of concurrent fetches; zero disables enrichment.
+	Workers   int `yaml:"workers" env-default:"4"`
+	QueueSize int `yaml:"queue_size" env-default:"1000"`
+	// Timeout bounds a whole fetch, redirects and body included.
+	Timeout time.Duration `yaml:"timeout" env-default:"5s"`
+	// MaxBytes is how much of a page is read looking for its metadata.
+	MaxBytes     int64  `yaml:"max_bytes" env-default:"524288"`
+	MaxRedirects int    `yaml:"max_redirects" env-default:"3"`
+	UserAgent    string `yaml:"user_agent" env-default:"urlSh-preview/1.0"`
+}
+
+// Unlock rate limits password attempts on protected links, per alias.
+type Unlock struct {
+	MaxAttempts int           `yaml:"max_attempts" env-default:"5"`
+	Window      time.Duration `yaml:"window" env-default:"1m"`
+}
+
+// URL configures how destination URLs are validated and normalized.
+type URL struct {
+	Schemes        []string `yaml:"schemes" env-default:"http,https"`
+	StripTracking  bool     `yaml:"strip_tracking" env-default:"false"`
+	TrackingParams []string `yaml:"tracking_params" env-default:"utm_*,fbclid,gclid,dclid,gbraid,wbraid,msclkid,yclid,mc_cid,mc_eid,igshid,_hsenc,_hsmi"`
+}
+
+// Blocklist configures the file of blocked destinations. An empty path disables blocking.
+// The flag list of destinations shown behind the preview page is configured the same way.
+type Blocklist struct {
+	Path           string        `yaml:"path"`
+	ReloadInterval time.Duration `yaml:"reload_interval" env-default:"10s"`
+}
+
+// Chain configures how destinations on URL shorteners, ours included, are handled.
+type Chain struct {
+	// ShortDomains are the hosts our short links are served on.
+	ShortDomains []string `yaml:"short_domains" env-default:"localhost:8080"`
+	// Resolve replaces our own short links with their target instead of rejecting them.
+	Resolve        bool     `yaml:"resolve" env-default:"true"`
+	MaxDepth       int      `yaml:"max_depth" env-default:"5"`
+	ShortenerHosts []string `yaml:"shortener_hosts" env-default:"bit.ly,tinyurl.com,t.co,goo.gl,ow.ly,is.gd,buff.ly,rebrand.ly,cutt.ly,shorturl.at,tiny.cc,rb.gy"`
+}
+
+// Reserved extends the aliases reserved for the gateway routes.
+type Reserved struct {
+	Words []string `yaml:"words"`
+	// FilterProfanity keeps offensive words out of generated aliases.
+	FilterProfanity bool `yaml:"filter_profanity" env-default:"true"`
+}
+
+// Alias configures how short aliases are generated.
+type Alias struct {
+	// Strategy is one of "random", "counter" or "hashids".
+	Strategy      string `yaml:"strategy" env-default:"random"`
+	Length        int    `yaml:"length" env-default:"5"`
+	MaxAttempts   int    `yaml:"max_attempts" env-default:"5"`
+	GrowThreshold int    `yaml:"grow_threshold" env-default:"10"`
+	// Salt scrambles counter values for the "hashids" strategy.
+	Salt string `yaml:"salt"`
 }
 
 type Storage struct {
@@ -50,6 +144,10 @@ func MustLoadPath(configPath string) *Config {
 		panic("cannot read config: " + err.Error())
 	}
 
+	if cfg.Alias.MaxAttempts < 1 {
+		panic("alias.max_attempts must be at least 1")
+	}
+
 	return &cfg
 }
 
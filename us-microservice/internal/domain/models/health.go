@@ -0,0 +1,29 @@
+package models
+
+import "time"
+
+// Health is the outcome of the latest checks of a link's destination.
+type Health struct {
+	// Status is the HTTP status of the last check; zero when no response came back.
+	Status int
+	// Error tells why the last check failed to get a response.
+	Error     string
+	Latency   time.Duration
+	CheckedAt time.Time
+	// LastHealthyAt is when the destination last answered with a 2xx or 3xx status; zero if never.
+	LastHealthyAt time.Time
+	// Failures counts the consecutive failed checks.
+	Failures int
+	// Broken is set once Failures reaches the configured threshold.
+	Broken bool
+}
+
+// IsZero reports whether the destination has not been checked yet.
+func (h Health) IsZero() bool {
+	return h.CheckedAt.IsZero()
+}
+
+// Healthy reports whether the last check succeeded.
+func (h Health) Healthy() bool {
+	return h.Status >= 200 && h.Status < 400
+}
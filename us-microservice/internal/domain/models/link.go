@@ -0,0 +1,239 @@
+package models
+
+import "time"
+
+// Link is a stored short link.
+type Link struct {
+	Alias     string
+	URL       string
+	UserId    int64
+	Title     string
+	CreatedAt time.Time
+	// Disabled links keep their alias but no longer redirect.
+	Disabled bool
+	// ActiveFrom is when the link starts redirecting; zero means right away.
+	ActiveFrom time.Time
+	// ExpiresAt is when the link stops redirecting; zero means never.
+	ExpiresAt time.Time
+	// Password is the plain passphrase received on creation. It is hashed into
+	// PasswordHash before the link is stored and never persisted itself.
+	Password     string
+	PasswordHash []byte
+	// MaxClicks is the number of redirects the link serves; zero means unlimited.
+	MaxClicks int64
+	// PurgeAt is when the storage may drop the link and release its alias; zero means never.
+	PurgeAt time.Time
+	// Rules pick another destination for some visitors. The first matching
+	// rule wins; URL is used when none matches.
+	Rules []TargetRule
+	// Variants split the visitors no rule matched between several destinations by weight.
+	Variants []Variant
+	// Passthrough forwards the path suffix and query of the request to the destination.
+	Passthrough Passthrough
+	// Redirect customizes the redirect response; the zero value is a plain 302.
+	Redirect Redirect
+	// UTMTemplate is the ID of the UTMTemplate whose parameters tag the destinations, if any.
+	UTMTemplate string
+	// DeepLink opens a mobile app instead of the destination on platforms it has an app URI for.
+	DeepLink DeepLink
+	// OwnerName is the display name of the owner when the link was created, shown on its preview.
+	OwnerName string
+	// Social overrides the metadata link unfurlers show instead of the destination's.
+	Social SocialCard
+	// Page is fetched from the destination in the background after the link is
+	// created or its destination changes; zero until then.
+	Page PageMeta
+	// Health is the outcome of the periodic checks of URL; zero until the first one.
+	Health Health
+}
+
+// PageMeta is what the destination page says about itself.
+type PageMeta struct {
+	Title       string
+	Description string
+	// Favicon is an absolute URL of the page icon.
+	Favicon   string
+	FetchedAt time.Time
+}
+
+// IsZero reports whether the metadata has not been fetched.
+func (p PageMeta) IsZero() bool {
+	return p == PageMeta{}
+}
+
+// SocialCard is the Open Graph and Twitter card metadata of a link.
+type SocialCard struct {
+	Title       string
+	Description string
+	// Image is an absolute http(s) URL.
+	Image string
+}
+
+// IsZero reports whether the link has no metadata of its own.
+func (s SocialCard) IsZero() bool {
+	return s == SocialCard{}
+}
+
+// Statuses of a link on its preview.
+const (
+	StatusActive       = "active"
+	StatusDisabled     = "disabled"
+	StatusNotYetActive = "not_yet_active"
+	StatusExpired      = "expired"
+	StatusBlocked      = "blocked"
+)
+
+// Preview is what visitors may learn about a link before following it.
+type Preview struct {
+	Alias string
+	// URL is the main destination; empty for password-protected and blocked links.
+	URL       string
+	Title     string
+	CreatedAt time.Time
+	OwnerName string
+	// Status is one of the Status* constants.
+	Status string
+	// Flagged links are shown behind the preview page on every visit.
+	Flagged   bool
+	Protected bool
+	// Varies reports whether visitors may be sent elsewhere than URL.
+	Varies bool
+	// Page is the metadata of URL; zero when URL is hidden.
+	Page PageMeta
+}
+
+// Destination is where a visitor of a short link is sent, and how.
+type Destination struct {
+	URL string
+	// AppURL is tried first when set; URL is then the web fallback.
+	AppURL   string
+	Redirect Redirect
+	// Interstitial asks for the preview page to be shown instead of redirecting.
+	Interstitial bool
+	// Social is served to link unfurlers instead of redirecting them, unless zero.
+	Social SocialCard
+	// Variant names the split variant the visitor was sent to, if any.
+	Variant string
+}
+
+// DeepLink holds the app URIs of a link. They are custom scheme URIs such as
+// myapp://item/42, universal or app links, or on Android intent:// URIs.
+type DeepLink struct {
+	IOS     string
+	Android string
+	// Fallback is where visitors without the app go; empty means the link destination.
+	Fallback string
+}
+
+// Enabled reports whether the link opens an app on some platform.
+func (d DeepLink) Enabled() bool {
+	return d.IOS != "" || d.Android != ""
+}
+
+// Redirect controls the status code and caching headers of a redirect.
+type Redirect struct {
+	// Status is 301, 302, 307 or 308; zero means 302.
+	Status         int
+	CacheControl   string
+	ReferrerPolicy string
+	// RobotsTag is sent as X-Robots-Tag.
+	RobotsTag string
+}
+
+// IsZero reports whether the redirect is a plain 302 without extra headers.
+func (r Redirect) IsZero() bool {
+	return r == Redirect{}
+}
+
+// Query conflict rules of Passthrough, for parameters both on the destination and the request.
+const (
+	ConflictKeepDestination = "destination"
+	ConflictOverride        = "request"
+	ConflictAppend          = "append"
+)
+
+// Passthrough controls which parts of the request are forwarded to the destination.
+type Passthrough struct {
+	// Path appends what follows the alias, /{alias}/docs/v2, to the destination path.
+	Path bool
+	// Query merges the request query into the destination query.
+	Query bool
+	// Conflict is one of the Conflict* rules; ConflictKeepDestination when empty.
+	Conflict string
+}
+
+// Enabled reports whether any part of the request is forwarded.
+func (p Passthrough) Enabled() bool {
+	return p.Path || p.Query
+}
+
+// Variant is one destination of a split link.
+type Variant struct {
+	// Name labels the variant in analytics.
+	Name   string
+	URL    string
+	Weight int
+}
+
+// TargetRule sends visitors matching all of its non-empty conditions to URL.
+type TargetRule struct {
+	// OS lists operating systems: "ios", "android", "windows", "macos", "linux" or "chromeos".
+	OS []string
+	// Languages lists language tags; "de" also matches "de-AT".
+	Languages []string
+	// Countries lists ISO 3166-1 alpha-2 codes.
+	Countries []string
+	// UserAgent is a case-insensitive regular expression matched against the User-Agent header.
+	UserAgent string
+	URL       string
+}
+
+// Visitor describes the request being redirected, as far as targeting and passthrough need it.
+type Visitor struct {
+	// ID is a stable random identifier of the browser; it keeps the variant of a split link sticky.
+	ID             string
+	UserAgent      string
+	AcceptLanguage string
+	// Country is an ISO 3166-1 alpha-2 code, empty when unknown.
+	Country string
+	// Path is the request path after the alias, without the leading slash.
+	Path string
+	// Query is the raw query of the request.
+	Query string
+	// Source tells how the visitor reached the link, e.g. "qr"; empty for clicks.
+	Source string
+	// Crawler marks link unfurlers, whose accesses are not counted.
+	Crawler bool
+}
+
+// NotYetActive reports whether the activation window of the link has not opened yet.
+func (l Link) NotYetActive(now time.Time) bool {
+	return !l.ActiveFrom.IsZero() && now.Before(l.ActiveFrom)
+}
+
+// Protected reports whether the link asks for a password before redirecting.
+func (l Link) Protected() bool {
+	return len(l.PasswordHash) > 0
+}
+
+// Expired reports whether the link has passed its expiration time.
+func (l Link) Expired(now time.Time) bool {
+	return !l.ExpiresAt.IsZero() && !now.Before(l.ExpiresAt)
+}
+
+// CacheEntry is a destination cached under an alias.
+type CacheEntry struct {
+	Alias      string
+	URL        string
+	Expiration time.Duration
+}
+
+// ListOptions selects a page of a user's links.
+type ListOptions struct {
+	UserId int64
+	// Query is a case-insensitive substring the destination must contain.
+	Query       string
+	Cursor      string
+	Limit       int
+	OldestFirst bool
+}
@@ -3,4 +3,8 @@ package models
 type Url struct {
 	UrlText string
 	UserId  int64
+	// Variant names the destination an access event was sent to, for split links.
+	Variant string
+	// Source tells how the visitor reached the link, e.g. "qr"; empty for clicks.
+	Source string
 }
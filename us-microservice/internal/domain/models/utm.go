@@ -0,0 +1,41 @@
+package models
+
+import "time"
+
+// UTMTemplate is a reusable set of utm_* parameters owned by a user. Links
+// created with a template get its parameters merged into their destinations.
+type UTMTemplate struct {
+	ID     string
+	UserId int64
+	Name   string
+	// Source, Medium, Campaign, Term and Content are the values of the
+	// utm_source, utm_medium, utm_campaign, utm_term and utm_content parameters;
+	// empty ones are not set.
+	Source    string
+	Medium    string
+	Campaign  string
+	Term      string
+	Content   string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	// Pending names the parameters of earlier versions that may still be on
+	// links because re-tagging them has not finished yet.
+	Pending []string
+}
+
+// Params returns the query parameters of the template in a fixed order.
+func (t UTMTemplate) Params() [][2]string {
+	var params [][2]string
+	for _, p := range [][2]string{
+		{"utm_source", t.Source},
+		{"utm_medium", t.Medium},
+		{"utm_campaign", t.Campaign},
+		{"utm_term", t.Term},
+		{"utm_content", t.Content},
+	} {
+		if p[1] != "" {
+			params = append(params, p)
+		}
+	}
+	return params
+}
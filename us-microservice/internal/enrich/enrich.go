@@ -0,0 +1,114 @@
+// Package enrich fetches the title, description and icon of link destinations
+// in the background and stores them on the links.
+package enrich
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+	"urlSh/internal/domain/models"
+	"urlSh/internal/safehttp"
+)
+
+// Storage stores fetched metadata on a link.
+type Storage interface {
+	// SetPageMeta stores meta on the link unless its destination is no longer url.
+	SetPageMeta(ctx context.Context, alias, url string, meta models.PageMeta) error
+}
+
+// Options bounds what the worker fetches.
+type Options struct {
+	// Workers is the number of concurrent fetches; zero disables enrichment.
+	Workers int
+	// QueueSize is the number of links waiting to be fetched; more are dropped.
+	QueueSize int
+	// Timeout bounds a whole fetch, redirects and body included.
+	Timeout time.Duration
+	// MaxBytes is how much of a page is read looking for its metadata.
+	MaxBytes     int64
+	MaxRedirects int
+	UserAgent    string
+}
+
+type job struct {
+	alias string
+	url   string
+}
+
+type Enricher struct {
+	log     *slog.Logger
+	storage Storage
+	client  *http.Client
+	opts    Options
+	jobs    chan job
+}
+
+func New(log *slog.Logger, storage Storage, opts Options) *Enricher {
+	e := &Enricher{
+		log:     log,
+		storage: storage,
+		client:  safehttp.NewClient(opts.Timeout, opts.MaxRedirects),
+		opts:    opts,
+	}
+	if opts.Workers > 0 {
+		e.jobs = make(chan job, opts.QueueSize)
+	}
+	return e
+}
+
+// Enqueue schedules the destination of alias to be fetched. It never blocks:
+// when the queue is full the link is skipped and stays without metadata.
+func (e *Enricher) Enqueue(alias, url string) {
+	if e.jobs == nil {
+		return
+	}
+	select {
+	case e.jobs <- job{alias: alias, url: url}:
+	default:
+		e.log.Warn("enrichment queue is full, skipping link", slog.String("alias", alias))
+	}
+}
+
+// Run fetches queued destinations with opts.Workers workers until ctx is done.
+func (e *Enricher) Run(ctx context.Context) {
+	if e.jobs == nil {
+		return
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < e.opts.Workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case j := <-e.jobs:
+					e.enrich(ctx, j)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func (e *Enricher) enrich(ctx context.Context, j job) {
+	ctx, cancel := context.WithTimeout(ctx, e.opts.Timeout)
+	defer cancel()
+
+	meta, err := fetch(ctx, e.client, j.url, e.opts)
+	if err != nil {
+		e.log.Info("failed to fetch destination metadata", slog.String("alias", j.alias), slog.String("err", err.Error()))
+		return
+	}
+
+	// The fetch may have used up the timeout; the write gets its own.
+	ctx, cancel = context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+	defer cancel()
+	if err := e.storage.SetPageMeta(ctx, j.alias, j.url, meta); err != nil {
+		e.log.Error("failed to store destination metadata", slog.String("alias", j.alias), slog.String("err", err.Error()))
+	}
+}
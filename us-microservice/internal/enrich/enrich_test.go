@@ -0,0 +1,77 @@
+package enrich
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+	"urlSh/internal/domain/models"
+)
+
+type fakeStorage struct {
+	mu    sync.Mutex
+	metas map[string]models.PageMeta
+	done  chan struct{}
+}
+
+func (s *fakeStorage) SetPageMeta(ctx context.Context, alias, url string, meta models.PageMeta) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.metas[alias] = meta
+	s.done <- struct{}{}
+	return nil
+}
+
+func TestEnricher(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<head><title>` + r.URL.Path + `</title></head>`))
+	}))
+	defer srv.Close()
+
+	storage := &fakeStorage{metas: make(map[string]models.PageMeta), done: make(chan struct{}, 2)}
+	e := New(slog.New(slog.NewTextHandler(io.Discard, nil)), storage, Options{
+		Workers:   1,
+		QueueSize: 2,
+		Timeout:   time.Second,
+		MaxBytes:  1024,
+	})
+	// The safe client refuses the loopback test server.
+	e.client = srv.Client()
+
+	e.Enqueue("a", srv.URL+"/a")
+	e.Enqueue("b", srv.URL+"/b")
+	e.Enqueue("c", srv.URL+"/c") // dropped, the queue is full
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+	go func() {
+		e.Run(ctx)
+		close(stopped)
+	}()
+	for i := 0; i < 2; i++ {
+		select {
+		case <-storage.done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("metadata was not stored")
+		}
+	}
+	cancel()
+	<-stopped
+
+	storage.mu.Lock()
+	defer storage.mu.Unlock()
+	if len(storage.metas) != 2 || storage.metas["a"].Title != "/a" || storage.metas["b"].Title != "/b" {
+		t.Errorf("stored %+v, want titles /a and /b only", storage.metas)
+	}
+}
+
+func TestEnqueueDisabled(t *testing.T) {
+	e := New(slog.New(slog.NewTextHandler(io.Discard, nil)), &fakeStorage{}, Options{})
+	e.Enqueue("a", "https://example.com/")
+	e.Run(context.Background()) // returns at once without workers
+}
@@ -0,0 +1,163 @@
+package enrich
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+	"urlSh/internal/domain/models"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// Bounds of the stored metadata, in characters.
+const (
+	maxTitle       = 200
+	maxDescription = 500
+	maxFavicon     = 2048
+)
+
+// fetch downloads at most opts.MaxBytes of the destination and reads the
+// metadata from its head.
+func fetch(ctx context.Context, client *http.Client, rawURL string, opts Options) (models.PageMeta, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return models.PageMeta{}, err
+	}
+	if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+		return models.PageMeta{}, fmt.Errorf("unsupported scheme %q", req.URL.Scheme)
+	}
+	req.Header.Set("User-Agent", opts.UserAgent)
+	req.Header.Set("Accept", "text/html,application/xhtml+xml")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return models.PageMeta{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return models.PageMeta{}, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if mediaType != "text/html" && mediaType != "application/xhtml+xml" {
+		return models.PageMeta{}, fmt.Errorf("unsupported content type %q", mediaType)
+	}
+
+	meta := parseHead(io.LimitReader(resp.Body, opts.MaxBytes), resp.Request.URL)
+	meta.FetchedAt = time.Now().UTC()
+	return meta, nil
+}
+
+// parseHead reads the title, description and icon of an HTML page, stopping
+// at the body. Relative icon URLs are resolved against base; /favicon.ico is
+// assumed when the page names none.
+func parseHead(r io.Reader, base *url.URL) models.PageMeta {
+	var meta models.PageMeta
+	var ogTitle, ogDescription, icon, touchIcon string
+
+	z := html.NewTokenizer(r)
+	inTitle := false
+loop:
+	for {
+		tt := z.Next()
+		switch tt {
+		case html.ErrorToken:
+			break loop
+		case html.TextToken:
+			if inTitle {
+				meta.Title += string(z.Text())
+			}
+		case html.EndTagToken:
+			name, _ := z.TagName()
+			switch atom.Lookup(name) {
+			case atom.Title:
+				inTitle = false
+			case atom.Head:
+				break loop
+			}
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, hasAttr := z.TagName()
+			a := atom.Lookup(name)
+			if a == atom.Body {
+				break loop
+			}
+			if a == atom.Title {
+				inTitle = tt == html.StartTagToken
+				continue
+			}
+			attrs := make(map[string]string)
+			for hasAttr {
+				var k, v []byte
+				k, v, hasAttr = z.TagAttr()
+				attrs[string(k)] = string(v)
+			}
+			switch a {
+			case atom.Meta:
+				switch strings.ToLower(attrs["name"] + attrs["property"]) {
+				case "description":
+					meta.Description = attrs["content"]
+				case "og:title":
+					ogTitle = attrs["content"]
+				case "og:description":
+					ogDescription = attrs["content"]
+				}
+			case atom.Link:
+				for _, rel := range strings.Fields(strings.ToLower(attrs["rel"])) {
+					switch rel {
+					case "icon":
+						if icon == "" {
+							icon = attrs["href"]
+						}
+					case "apple-touch-icon":
+						if touchIcon == "" {
+							touchIcon = attrs["href"]
+						}
+					}
+				}
+			}
+		}
+	}
+
+	meta.Title = clean(firstNonEmpty(meta.Title, ogTitle), maxTitle)
+	meta.Description = clean(firstNonEmpty(meta.Description, ogDescription), maxDescription)
+	meta.Favicon = resolveIcon(base, firstNonEmpty(icon, touchIcon, "/favicon.ico"))
+	return meta
+}
+
+// resolveIcon returns the absolute http(s) URL of an icon, or "".
+func resolveIcon(base *url.URL, href string) string {
+	ref, err := url.Parse(strings.TrimSpace(href))
+	if err != nil {
+		return ""
+	}
+	abs := base.ResolveReference(ref)
+	if (abs.Scheme != "http" && abs.Scheme != "https") || len(abs.String()) > maxFavicon {
+		return ""
+	}
+	return abs.String()
+}
+
+// clean collapses whitespace, drops invalid UTF-8 and cuts s to max characters.
+func clean(s string, max int) string {
+	s = strings.Join(strings.Fields(strings.ToValidUTF8(s, "")), " ")
+	if utf8.RuneCountInString(s) <= max {
+		return s
+	}
+	return string([]rune(s)[:max-1]) + "…"
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if strings.TrimSpace(v) != "" {
+			return v
+		}
+	}
+	return ""
+}
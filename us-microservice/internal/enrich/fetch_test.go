@@ -0,0 +1,173 @@
+package enrich
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseHead(t *testing.T) {
+	base, _ := url.Parse("https://example.com/docs/page")
+
+	tests := []struct {
+		name        string
+		html        string
+		title       string
+		description string
+		favicon     string
+	}{
+		{
+			name:        "title and description",
+			html:        `<html><head><title> Hello   World </title><meta name="description" content="About it"><link rel="icon" href="/icon.png"></head></html>`,
+			title:       "Hello World",
+			description: "About it",
+			favicon:     "https://example.com/icon.png",
+		},
+		{
+			name:        "open graph fallback",
+			html:        `<head><meta property="og:title" content="OG title"><meta property="OG:Description" content="OG description"></head>`,
+			title:       "OG title",
+			description: "OG description",
+			favicon:     "https://example.com/favicon.ico",
+		},
+		{
+			name:        "title wins over open graph",
+			html:        `<head><meta property="og:title" content="OG title"><title>Title</title><meta name="description" content="Plain"><meta property="og:description" content="OG"></head>`,
+			title:       "Title",
+			description: "Plain",
+			favicon:     "https://example.com/favicon.ico",
+		},
+		{
+			name:    "relative icon",
+			html:    `<head><link rel="shortcut icon" href="img/fav.ico"></head>`,
+			favicon: "https://example.com/docs/img/fav.ico",
+		},
+		{
+			name:    "touch icon when no icon",
+			html:    `<head><link rel="apple-touch-icon" href="//cdn.example.net/touch.png"></head>`,
+			favicon: "https://cdn.example.net/touch.png",
+		},
+		{
+			name:    "non-http icon dropped",
+			html:    `<head><link rel="icon" href="javascript:alert(1)"></head>`,
+			favicon: "",
+		},
+		{
+			name:    "stops at body",
+			html:    `<head></head><body><title>Not the title</title><meta name="description" content="nope"></body>`,
+			favicon: "https://example.com/favicon.ico",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseHead(strings.NewReader(tt.html), base)
+			if got.Title != tt.title || got.Description != tt.description || got.Favicon != tt.favicon {
+				t.Errorf("parseHead() = %q, %q, %q; want %q, %q, %q",
+					got.Title, got.Description, got.Favicon, tt.title, tt.description, tt.favicon)
+			}
+		})
+	}
+}
+
+func TestClean(t *testing.T) {
+	tests := []struct {
+		s    string
+		max  int
+		want string
+	}{
+		{"  a \n\t b  ", 10, "a b"},
+		{"abc", 3, "abc"},
+		{"abcd", 3, "ab…"},
+		{"äöüß", 3, "äö…"},
+		{"bad\xffbyte", 20, "badbyte"},
+		{"", 5, ""},
+	}
+	for _, tt := range tests {
+		if got := clean(tt.s, tt.max); got != tt.want {
+			t.Errorf("clean(%q, %d) = %q, want %q", tt.s, tt.max, got, tt.want)
+		}
+	}
+}
+
+func TestFetch(t *testing.T) {
+	padding := strings.Repeat("<!-- padding -->", 100)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/page", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write([]byte(`<head><title>Page</title></head>`))
+	})
+	mux.HandleFunc("/moved", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/page", http.StatusFound)
+	})
+	mux.HandleFunc("/image", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "image/png")
+		w.Write([]byte(`<head><title>Image</title></head>`))
+	})
+	mux.HandleFunc("/gone", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.WriteHeader(http.StatusGone)
+		w.Write([]byte(`<head><title>Gone</title></head>`))
+	})
+	mux.HandleFunc("/large", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<head>` + padding + `<title>Too far</title></head>`))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	opts := Options{MaxBytes: int64(len(padding)), UserAgent: "test"}
+
+	tests := []struct {
+		path    string
+		title   string
+		favicon string
+		wantErr bool
+	}{
+		{path: "/page", title: "Page", favicon: srv.URL + "/favicon.ico"},
+		{path: "/moved", title: "Page", favicon: srv.URL + "/favicon.ico"},
+		{path: "/large", title: "", favicon: srv.URL + "/favicon.ico"},
+		{path: "/image", wantErr: true},
+		{path: "/gone", wantErr: true},
+		{path: "/missing", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			meta, err := fetch(context.Background(), srv.Client(), srv.URL+tt.path, opts)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("fetch succeeded with %+v, want an error", meta)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("fetch: %v", err)
+			}
+			if meta.Title != tt.title || meta.Favicon != tt.favicon || meta.FetchedAt.IsZero() {
+				t.Errorf("fetch() = %+v, want title %q and favicon %q", meta, tt.title, tt.favicon)
+			}
+		})
+	}
+}
+
+func TestFetchUnsupportedScheme(t *testing.T) {
+	if _, err := fetch(context.Background(), http.DefaultClient, "ftp://example.com/", Options{}); err == nil {
+		t.Error("fetch of an ftp URL succeeded")
+	}
+}
+
+func TestFetchTimeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := fetch(ctx, srv.Client(), srv.URL, Options{MaxBytes: 1024}); err == nil {
+		t.Error("fetch of a hanging server succeeded")
+	}
+}
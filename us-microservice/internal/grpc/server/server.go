@@ -4,15 +4,51 @@ import (
 	"context"
 	"errors"
 	pb "github.com/yberikov/us-protos/gen/us-microservice"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+	"urlSh/internal/domain/models"
+	"urlSh/internal/services"
 	"urlSh/internal/storage"
 )
 
+// ErrorDomain and the Reason* constants identify errdetails.ErrorInfo attached to statuses.
+const (
+	ErrorDomain            = "urlSh"
+	ReasonNotYetActive     = "LINK_NOT_YET_ACTIVE"
+	ReasonPasswordRequired = "LINK_PASSWORD_REQUIRED"
+	ReasonBlocked          = "LINK_BLOCKED"
+)
+
+// Metadata keys describing the visitor of a redirect, set by the gateway.
+const (
+	MetadataUserAgent      = "x-visitor-user-agent"
+	MetadataAcceptLanguage = "x-visitor-accept-language"
+	MetadataCountry        = "x-visitor-country"
+	MetadataVisitorID      = "x-visitor-id"
+)
+
 type URLShortener interface {
-	ShortenUrl(ctx context.Context, originalURL string, userId int64) (shortURL string, err error)
-	GetOriginalUrl(ctx context.Context, shortURL string) (originalURL string, err error)
+	ShortenUrl(ctx context.Context, link models.Link) (shortURL string, err error)
+	ShortenUrls(ctx context.Context, links []models.Link) ([]services.BatchResult, error)
+	GetOriginalUrl(ctx context.Context, shortURL string, visitor models.Visitor) (models.Destination, error)
+	ListUserUrls(ctx context.Context, opts models.ListOptions) (links []models.Link, nextCursor string, err error)
+	UpdateUrl(ctx context.Context, shortURL string, userId int64, originalURL string) (models.Link, error)
+	DisableUrl(ctx context.Context, shortURL string, userId int64, disabled bool) (models.Link, error)
+	DeleteUrl(ctx context.Context, shortURL string, userId int64) error
+	UnlockUrl(ctx context.Context, shortURL, password string, visitor models.Visitor) (models.Destination, error)
+	PreviewUrl(ctx context.Context, shortURL string) (models.Preview, error)
+	GetUrlHealth(ctx context.Context, shortURL string, userId int64) (models.Health, error)
+
+	CreateTemplate(ctx context.Context, t models.UTMTemplate) (models.UTMTemplate, error)
+	GetTemplate(ctx context.Context, id string, userId int64) (models.UTMTemplate, error)
+	ListTemplates(ctx context.Context, userId int64) ([]models.UTMTemplate, error)
+	UpdateTemplate(ctx context.Context, t models.UTMTemplate, propagate bool) (updated models.UTMTemplate, updatedLinks int, err error)
+	DeleteTemplate(ctx context.Context, id string, userId int64) error
 }
 
 type serverAPI struct {
@@ -28,18 +64,63 @@ func (s *serverAPI) ShortenUrl(
 	ctx context.Context,
 	in *pb.ShortenUrlRequest,
 ) (*pb.ShortenUrlResponse, error) {
-	if in.OriginalUrl == "" {
-		return nil, status.Error(codes.InvalidArgument, "original_url is required")
+	link, err := toModelLink(in)
+	if err != nil {
+		return nil, err
 	}
 
-	shortURL, err := s.shortener.ShortenUrl(ctx, in.OriginalUrl, in.UserId)
+	shortURL, err := s.shortener.ShortenUrl(ctx, link)
 	if err != nil {
-		return nil, status.Error(codes.Internal, "failed to shorten URL")
+		return nil, shortenError(err)
 	}
 
 	return &pb.ShortenUrlResponse{ShortUrl: shortURL}, nil
 }
 
+func (s *serverAPI) ShortenUrls(
+	ctx context.Context,
+	in *pb.ShortenUrlsRequest,
+) (*pb.ShortenUrlsResponse, error) {
+	if len(in.Items) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "items are required")
+	}
+
+	// Items rejected here never reach the shortener; valid holds the
+	// positions of the others so the results can be put back in order.
+	results := make([]*pb.ShortenUrlsResult, len(in.Items))
+	links := make([]models.Link, 0, len(in.Items))
+	valid := make([]int, 0, len(in.Items))
+	for i, item := range in.Items {
+		link, err := toModelLink(item)
+		if err != nil {
+			results[i] = batchResult("", err)
+			continue
+		}
+		link.UserId = in.UserId
+		links = append(links, link)
+		valid = append(valid, i)
+	}
+
+	if len(links) > 0 {
+		batch, err := s.shortener.ShortenUrls(ctx, links)
+		if err != nil {
+			if errors.Is(err, services.ErrBatchTooLarge) {
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			}
+			return nil, status.Error(codes.Internal, "failed to shorten URLs")
+		}
+		for j, res := range batch {
+			if res.Err != nil {
+				results[valid[j]] = batchResult("", shortenError(res.Err))
+			} else {
+				results[valid[j]] = batchResult(res.Alias, nil)
+			}
+		}
+	}
+
+	return &pb.ShortenUrlsResponse{Results: results}, nil
+}
+
 func (s *serverAPI) GetOriginalUrl(
 	ctx context.Context,
 	in *pb.GetOriginalUrlRequest,
@@ -48,13 +129,478 @@ func (s *serverAPI) GetOriginalUrl(
 		return nil, status.Error(codes.InvalidArgument, "short_url is required")
 	}
 
-	originalURL, err := s.shortener.GetOriginalUrl(ctx, in.GetShortUrl())
+	visitor := visitorFromContext(ctx)
+	visitor.Path, visitor.Query, visitor.Source = in.Path, in.Query, in.Source
+	visitor.Crawler = in.Crawler
+
+	dest, err := s.shortener.GetOriginalUrl(ctx, in.GetShortUrl(), visitor)
+	if err != nil {
+		return nil, resolveError(err)
+	}
+
+	return &pb.GetOriginalUrlResponse{
+		OriginalUrl: dest.URL,
+		Redirect:    toProtoRedirect(dest.Redirect),
+		AppUrl:      dest.AppURL,
+
+		Interstitial: dest.Interstitial,
+		Social:       toProtoSocial(dest.Social),
+		Variant:      dest.Variant,
+	}, nil
+}
+
+func (s *serverAPI) GetLinkPreview(
+	ctx context.Context,
+	in *pb.GetLinkPreviewRequest,
+) (*pb.GetLinkPreviewResponse, error) {
+	if in.ShortUrl == "" {
+		return nil, status.Error(codes.InvalidArgument, "short_url is required")
+	}
+
+	p, err := s.shortener.PreviewUrl(ctx, in.ShortUrl)
 	if err != nil {
 		if errors.Is(err, storage.ErrURLNotFound) {
 			return nil, status.Error(codes.NotFound, "short URL not found")
 		}
-		return nil, status.Error(codes.Internal, "failed to get original URL")
+		return nil, status.Error(codes.Internal, "failed to preview URL")
+	}
+
+	return &pb.GetLinkPreviewResponse{
+		Alias:       p.Alias,
+		OriginalUrl: p.URL,
+		Title:       p.Title,
+		CreatedAt:   timestamppb.New(p.CreatedAt),
+		OwnerName:   p.OwnerName,
+		Status:      p.Status,
+		Flagged:     p.Flagged,
+		Varies:      p.Varies,
+		Page:        toProtoPage(p.Page),
+
+		PasswordProtected: p.Protected,
+	}, nil
+}
+
+func (s *serverAPI) GetLinkHealth(
+	ctx context.Context,
+	in *pb.GetLinkHealthRequest,
+) (*pb.GetLinkHealthResponse, error) {
+	if in.ShortUrl == "" {
+		return nil, status.Error(codes.InvalidArgument, "short_url is required")
+	}
+
+	h, err := s.shortener.GetUrlHealth(ctx, in.ShortUrl, in.UserId)
+	if err != nil {
+		return nil, ownerError(err, "failed to get URL health")
+	}
+
+	return &pb.GetLinkHealthResponse{Health: toProtoHealth(h)}, nil
+}
+
+func (s *serverAPI) UnlockUrl(
+	ctx context.Context,
+	in *pb.UnlockUrlRequest,
+) (*pb.UnlockUrlResponse, error) {
+	if in.ShortUrl == "" || in.Password == "" {
+		return nil, status.Error(codes.InvalidArgument, "short_url and password are required")
+	}
+
+	visitor := visitorFromContext(ctx)
+	visitor.Path, visitor.Query, visitor.Source = in.Path, in.Query, in.Source
+
+	dest, err := s.shortener.UnlockUrl(ctx, in.ShortUrl, in.Password, visitor)
+	if err != nil {
+		if errors.Is(err, services.ErrWrongPassword) {
+			return nil, status.Error(codes.PermissionDenied, "wrong password")
+		}
+		if errors.Is(err, services.ErrTooManyAttempts) {
+			return nil, status.Error(codes.ResourceExhausted, "too many attempts, try again later")
+		}
+		return nil, resolveError(err)
+	}
+
+	return &pb.UnlockUrlResponse{
+		OriginalUrl:  dest.URL,
+		Variant:      dest.Variant,
+		Redirect:     toProtoRedirect(dest.Redirect),
+		AppUrl:       dest.AppURL,
+		Interstitial: dest.Interstitial,
+	}, nil
+}
+
+// resolveError maps errors of resolving a short URL to gRPC statuses.
+func resolveError(err error) error {
+	var notActive *storage.NotYetActiveError
+	switch {
+	case errors.Is(err, storage.ErrURLNotFound):
+		return status.Error(codes.NotFound, "short URL not found")
+	case errors.Is(err, storage.ErrURLDisabled):
+		return status.Error(codes.FailedPrecondition, "short URL is disabled")
+	case errors.Is(err, storage.ErrURLExpired):
+		return status.Error(codes.FailedPrecondition, "short URL has expired")
+	case errors.Is(err, storage.ErrClickLimit):
+		return status.Error(codes.FailedPrecondition, "short URL has reached its click limit")
+	case errors.As(err, &notActive):
+		return detailedStatus(codes.NotFound, "short URL is not active yet", ReasonNotYetActive,
+			map[string]string{"active_from": notActive.ActiveFrom.Format(time.RFC3339)})
+	case errors.Is(err, services.ErrInvalidSuffix):
+		return status.Error(codes.InvalidArgument, "invalid path after the short URL")
+	case errors.Is(err, storage.ErrURLBlocked):
+		return detailedStatus(codes.PermissionDenied, "short URL destination is blocked", ReasonBlocked, nil)
+	case errors.Is(err, storage.ErrURLLocked):
+		return detailedStatus(codes.Unauthenticated, "short URL is password protected", ReasonPasswordRequired, nil)
+	default:
+		return status.Error(codes.Internal, "failed to get original URL")
+	}
+}
+
+func (s *serverAPI) ListUserUrls(
+	ctx context.Context,
+	in *pb.ListUserUrlsRequest,
+) (*pb.ListUserUrlsResponse, error) {
+	if in.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "userId is required")
+	}
+	if in.PageSize < 0 {
+		return nil, status.Error(codes.InvalidArgument, "page_size must not be negative")
+	}
+
+	links, next, err := s.shortener.ListUserUrls(ctx, models.ListOptions{
+		UserId:      in.UserId,
+		Query:       in.Query,
+		Cursor:      in.Cursor,
+		Limit:       int(in.PageSize),
+		OldestFirst: in.OldestFirst,
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrInvalidCursor) {
+			return nil, status.Error(codes.InvalidArgument, "invalid cursor")
+		}
+		return nil, status.Error(codes.Internal, "failed to list URLs")
+	}
+
+	resp := &pb.ListUserUrlsResponse{NextCursor: next}
+	for _, link := range links {
+		resp.Links = append(resp.Links, toProtoLink(link))
+	}
+
+	return resp, nil
+}
+
+func (s *serverAPI) UpdateUrl(
+	ctx context.Context,
+	in *pb.UpdateUrlRequest,
+) (*pb.UpdateUrlResponse, error) {
+	if in.ShortUrl == "" || in.OriginalUrl == "" {
+		return nil, status.Error(codes.InvalidArgument, "short_url and original_url are required")
+	}
+
+	link, err := s.shortener.UpdateUrl(ctx, in.ShortUrl, in.UserId, in.OriginalUrl)
+	if err != nil {
+		if errors.Is(err, services.ErrInvalidLink) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if errors.Is(err, storage.ErrURLBlocked) {
+			return nil, status.Error(codes.PermissionDenied, "destination is blocked")
+		}
+		return nil, ownerError(err, "failed to update URL")
+	}
+
+	return &pb.UpdateUrlResponse{Link: toProtoLink(link)}, nil
+}
+
+func (s *serverAPI) DisableUrl(
+	ctx context.Context,
+	in *pb.DisableUrlRequest,
+) (*pb.DisableUrlResponse, error) {
+	if in.ShortUrl == "" {
+		return nil, status.Error(codes.InvalidArgument, "short_url is required")
 	}
 
-	return &pb.GetOriginalUrlResponse{OriginalUrl: originalURL}, nil
+	link, err := s.shortener.DisableUrl(ctx, in.ShortUrl, in.UserId, !in.Enable)
+	if err != nil {
+		return nil, ownerError(err, "failed to disable URL")
+	}
+
+	return &pb.DisableUrlResponse{Link: toProtoLink(link)}, nil
+}
+
+func (s *serverAPI) DeleteUrl(
+	ctx context.Context,
+	in *pb.DeleteUrlRequest,
+) (*pb.DeleteUrlResponse, error) {
+	if in.ShortUrl == "" {
+		return nil, status.Error(codes.InvalidArgument, "short_url is required")
+	}
+
+	if err := s.shortener.DeleteUrl(ctx, in.ShortUrl, in.UserId); err != nil {
+		return nil, ownerError(err, "failed to delete URL")
+	}
+
+	return &pb.DeleteUrlResponse{}, nil
+}
+
+// detailedStatus attaches an errdetails.ErrorInfo to the status so the
+// gateway can tell apart link states sharing a code.
+func detailedStatus(code codes.Code, msg, reason string, metadata map[string]string) error {
+	st, err := status.New(code, msg).WithDetails(&errdetails.ErrorInfo{
+		Reason:   reason,
+		Domain:   ErrorDomain,
+		Metadata: metadata,
+	})
+	if err != nil {
+		return status.Error(code, msg)
+	}
+	return st.Err()
+}
+
+// ownerError maps errors of owner-only operations to gRPC statuses.
+func ownerError(err error, msg string) error {
+	switch {
+	case errors.Is(err, storage.ErrURLNotFound):
+		return status.Error(codes.NotFound, "short URL not found")
+	case errors.Is(err, storage.ErrNotOwner):
+		return status.Error(codes.PermissionDenied, "short URL belongs to another user")
+	default:
+		return status.Error(codes.Internal, msg)
+	}
+}
+
+// toModelLink validates a shorten request and converts it into a link.
+func toModelLink(in *pb.ShortenUrlRequest) (models.Link, error) {
+	if in.OriginalUrl == "" {
+		return models.Link{}, status.Error(codes.InvalidArgument, "original_url is required")
+	}
+	if in.ActiveUntil != nil && in.ExpiresAt != nil {
+		return models.Link{}, status.Error(codes.InvalidArgument, "set either expires_at or active_until, not both")
+	}
+	expiresAt := in.ExpiresAt
+	if in.ActiveUntil != nil {
+		expiresAt = in.ActiveUntil
+	}
+
+	return models.Link{
+		Alias:      in.CustomAlias,
+		URL:        in.OriginalUrl,
+		UserId:     in.UserId,
+		Title:      in.Title,
+		ActiveFrom: timeOrZero(in.ActiveFrom),
+		ExpiresAt:  timeOrZero(expiresAt),
+		MaxClicks:  in.MaxClicks,
+		Password:   in.Password,
+		Rules:      toModelRules(in.Rules),
+		Variants:   toModelVariants(in.Variants),
+		Passthrough: models.Passthrough{
+			Path:     in.GetPassthrough().GetPath(),
+			Query:    in.GetPassthrough().GetQuery(),
+			Conflict: in.GetPassthrough().GetConflict(),
+		},
+		Redirect: models.Redirect{
+			Status:         int(in.GetRedirect().GetStatus()),
+			CacheControl:   in.GetRedirect().GetCacheControl(),
+			ReferrerPolicy: in.GetRedirect().GetReferrerPolicy(),
+			RobotsTag:      in.GetRedirect().GetRobotsTag(),
+		},
+		UTMTemplate: in.UtmTemplate,
+		DeepLink: models.DeepLink{
+			IOS:      in.GetDeepLink().GetIos(),
+			Android:  in.GetDeepLink().GetAndroid(),
+			Fallback: in.GetDeepLink().GetFallback(),
+		},
+		OwnerName: in.OwnerName,
+		Social: models.SocialCard{
+			Title:       in.GetSocial().GetTitle(),
+			Description: in.GetSocial().GetDescription(),
+			Image:       in.GetSocial().GetImage(),
+		},
+	}, nil
+}
+
+// shortenError maps the errors of creating a link to gRPC status errors.
+func shortenError(err error) error {
+	if errors.Is(err, services.ErrInvalidLink) {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if errors.Is(err, storage.ErrURLExists) {
+		return status.Error(codes.AlreadyExists, "alias is already taken")
+	}
+	if errors.Is(err, services.ErrAliasSpaceExhausted) {
+		return status.Error(codes.Unavailable, "no free alias found, try again later")
+	}
+	if errors.Is(err, storage.ErrURLBlocked) {
+		return status.Error(codes.PermissionDenied, "destination is blocked")
+	}
+	return status.Error(codes.Internal, "failed to shorten URL")
+}
+
+// batchResult converts the outcome of one batch item; err must be a status error or nil.
+func batchResult(shortURL string, err error) *pb.ShortenUrlsResult {
+	st := status.Convert(err)
+	return &pb.ShortenUrlsResult{
+		ShortUrl: shortURL,
+		Code:     int32(st.Code()),
+		Error:    st.Message(),
+	}
+}
+
+func toProtoLink(link models.Link) *pb.Link {
+	return &pb.Link{
+		Alias:       link.Alias,
+		OriginalUrl: link.URL,
+		UserId:      link.UserId,
+		Title:       link.Title,
+		CreatedAt:   timestamppb.New(link.CreatedAt),
+		Disabled:    link.Disabled,
+		ExpiresAt:   timestampOrNil(link.ExpiresAt),
+		ActiveFrom:  timestampOrNil(link.ActiveFrom),
+		MaxClicks:   link.MaxClicks,
+		Rules:       toProtoRules(link.Rules),
+		Variants:    toProtoVariants(link.Variants),
+		Passthrough: toProtoPassthrough(link.Passthrough),
+		Redirect:    toProtoRedirect(link.Redirect),
+		UtmTemplate: link.UTMTemplate,
+		DeepLink:    toProtoDeepLink(link.DeepLink),
+		OwnerName:   link.OwnerName,
+		Social:      toProtoSocial(link.Social),
+		Page:        toProtoPage(link.Page),
+		Health:      toProtoHealth(link.Health),
+
+		PasswordProtected: link.Protected(),
+	}
+}
+
+func toProtoRules(rules []models.TargetRule) []*pb.TargetRule {
+	out := make([]*pb.TargetRule, 0, len(rules))
+	for _, r := range rules {
+		out = append(out, &pb.TargetRule{
+			Os:        r.OS,
+			Languages: r.Languages,
+			Countries: r.Countries,
+			UserAgent: r.UserAgent,
+			Url:       r.URL,
+		})
+	}
+	return out
+}
+
+func toModelRules(rules []*pb.TargetRule) []models.TargetRule {
+	out := make([]models.TargetRule, 0, len(rules))
+	for _, r := range rules {
+		out = append(out, models.TargetRule{
+			OS:        r.Os,
+			Languages: r.Languages,
+			Countries: r.Countries,
+			UserAgent: r.UserAgent,
+			URL:       r.Url,
+		})
+	}
+	return out
+}
+
+func toProtoPassthrough(p models.Passthrough) *pb.Passthrough {
+	if !p.Enabled() {
+		return nil
+	}
+	return &pb.Passthrough{Path: p.Path, Query: p.Query, Conflict: p.Conflict}
+}
+
+func toProtoDeepLink(d models.DeepLink) *pb.DeepLink {
+	if !d.Enabled() {
+		return nil
+	}
+	return &pb.DeepLink{Ios: d.IOS, Android: d.Android, Fallback: d.Fallback}
+}
+
+func toProtoSocial(s models.SocialCard) *pb.SocialCard {
+	if s.IsZero() {
+		return nil
+	}
+	return &pb.SocialCard{Title: s.Title, Description: s.Description, Image: s.Image}
+}
+
+func toProtoHealth(h models.Health) *pb.LinkHealth {
+	if h.IsZero() {
+		return nil
+	}
+	return &pb.LinkHealth{
+		Status:        int32(h.Status),
+		Error:         h.Error,
+		LatencyMs:     h.Latency.Milliseconds(),
+		CheckedAt:     timestamppb.New(h.CheckedAt),
+		LastHealthyAt: timestampOrNil(h.LastHealthyAt),
+		Failures:      int32(h.Failures),
+		Broken:        h.Broken,
+	}
+}
+
+func toProtoPage(p models.PageMeta) *pb.PageMetadata {
+	if p.IsZero() {
+		return nil
+	}
+	return &pb.PageMetadata{
+		Title:       p.Title,
+		Description: p.Description,
+		Favicon:     p.Favicon,
+		FetchedAt:   timestamppb.New(p.FetchedAt),
+	}
+}
+
+func toProtoRedirect(r models.Redirect) *pb.RedirectOptions {
+	if r.IsZero() {
+		return nil
+	}
+	return &pb.RedirectOptions{
+		Status:         int32(r.Status),
+		CacheControl:   r.CacheControl,
+		ReferrerPolicy: r.ReferrerPolicy,
+		RobotsTag:      r.RobotsTag,
+	}
+}
+
+func toProtoVariants(variants []models.Variant) []*pb.Variant {
+	out := make([]*pb.Variant, 0, len(variants))
+	for _, v := range variants {
+		out = append(out, &pb.Variant{Name: v.Name, Url: v.URL, Weight: int32(v.Weight)})
+	}
+	return out
+}
+
+func toModelVariants(variants []*pb.Variant) []models.Variant {
+	out := make([]models.Variant, 0, len(variants))
+	for _, v := range variants {
+		out = append(out, models.Variant{Name: v.Name, URL: v.Url, Weight: int(v.Weight)})
+	}
+	return out
+}
+
+// visitorFromContext reads the visitor attributes forwarded by the gateway.
+func visitorFromContext(ctx context.Context) models.Visitor {
+	md, _ := metadata.FromIncomingContext(ctx)
+	first := func(key string) string {
+		if v := md.Get(key); len(v) > 0 {
+			return v[0]
+		}
+		return ""
+	}
+
+	return models.Visitor{
+		ID:             first(MetadataVisitorID),
+		UserAgent:      first(MetadataUserAgent),
+		AcceptLanguage: first(MetadataAcceptLanguage),
+		Country:        first(MetadataCountry),
+	}
+}
+
+// timeOrZero maps a missing timestamp to the zero time; AsTime would return the Unix epoch.
+func timeOrZero(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
+func timestampOrNil(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
 }
@@ -0,0 +1,140 @@
+package server
+
+import (
+	"context"
+	"errors"
+	pb "github.com/yberikov/us-protos/gen/us-microservice"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"urlSh/internal/domain/models"
+	"urlSh/internal/services"
+	"urlSh/internal/storage"
+)
+
+func (s *serverAPI) CreateUtmTemplate(
+	ctx context.Context,
+	in *pb.CreateUtmTemplateRequest,
+) (*pb.UtmTemplateResponse, error) {
+	if in.GetTemplate().GetUserId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "userId is required")
+	}
+
+	t, err := s.shortener.CreateTemplate(ctx, toModelTemplate(in.Template))
+	if err != nil {
+		return nil, templateError(err, "failed to create UTM template")
+	}
+
+	return &pb.UtmTemplateResponse{Template: toProtoTemplate(t)}, nil
+}
+
+func (s *serverAPI) GetUtmTemplate(
+	ctx context.Context,
+	in *pb.GetUtmTemplateRequest,
+) (*pb.UtmTemplateResponse, error) {
+	if in.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	t, err := s.shortener.GetTemplate(ctx, in.Id, in.UserId)
+	if err != nil {
+		return nil, templateError(err, "failed to get UTM template")
+	}
+
+	return &pb.UtmTemplateResponse{Template: toProtoTemplate(t)}, nil
+}
+
+func (s *serverAPI) ListUtmTemplates(
+	ctx context.Context,
+	in *pb.ListUtmTemplatesRequest,
+) (*pb.ListUtmTemplatesResponse, error) {
+	if in.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "userId is required")
+	}
+
+	templates, err := s.shortener.ListTemplates(ctx, in.UserId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "failed to list UTM templates")
+	}
+
+	resp := &pb.ListUtmTemplatesResponse{}
+	for _, t := range templates {
+		resp.Templates = append(resp.Templates, toProtoTemplate(t))
+	}
+
+	return resp, nil
+}
+
+func (s *serverAPI) UpdateUtmTemplate(
+	ctx context.Context,
+	in *pb.UpdateUtmTemplateRequest,
+) (*pb.UpdateUtmTemplateResponse, error) {
+	if in.GetTemplate().GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "template id is required")
+	}
+
+	t, n, err := s.shortener.UpdateTemplate(ctx, toModelTemplate(in.Template), in.Propagate)
+	if err != nil {
+		return nil, templateError(err, "failed to update UTM template")
+	}
+
+	return &pb.UpdateUtmTemplateResponse{Template: toProtoTemplate(t), UpdatedLinks: int64(n)}, nil
+}
+
+func (s *serverAPI) DeleteUtmTemplate(
+	ctx context.Context,
+	in *pb.DeleteUtmTemplateRequest,
+) (*pb.DeleteUtmTemplateResponse, error) {
+	if in.Id == "" {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+
+	if err := s.shortener.DeleteTemplate(ctx, in.Id, in.UserId); err != nil {
+		return nil, templateError(err, "failed to delete UTM template")
+	}
+
+	return &pb.DeleteUtmTemplateResponse{}, nil
+}
+
+// templateError maps errors of template operations to gRPC statuses. Templates
+// of other users are reported as missing.
+func templateError(err error, msg string) error {
+	switch {
+	case errors.Is(err, services.ErrInvalidTemplate):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, storage.ErrTemplateNotFound):
+		return status.Error(codes.NotFound, "UTM template not found")
+	case errors.Is(err, storage.ErrTemplateExists):
+		return status.Error(codes.AlreadyExists, "a UTM template with this name already exists")
+	default:
+		return status.Error(codes.Internal, msg)
+	}
+}
+
+func toModelTemplate(t *pb.UtmTemplate) models.UTMTemplate {
+	return models.UTMTemplate{
+		ID:       t.GetId(),
+		UserId:   t.GetUserId(),
+		Name:     t.GetName(),
+		Source:   t.GetSource(),
+		Medium:   t.GetMedium(),
+		Campaign: t.GetCampaign(),
+		Term:     t.GetTerm(),
+		Content:  t.GetContent(),
+	}
+}
+
+func toProtoTemplate(t models.UTMTemplate) *pb.UtmTemplate {
+	return &pb.UtmTemplate{
+		Id:        t.ID,
+		UserId:    t.UserId,
+		Name:      t.Name,
+		Source:    t.Source,
+		Medium:    t.Medium,
+		Campaign:  t.Campaign,
+		Term:      t.Term,
+		Content:   t.Content,
+		CreatedAt: timestamppb.New(t.CreatedAt),
+		UpdatedAt: timestamppb.New(t.UpdatedAt),
+	}
+}
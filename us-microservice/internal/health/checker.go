@@ -0,0 +1,200 @@
+// Package health periodically checks that the destinations of active links
+// still answer, and records the outcome on the links.
+package health
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+	"urlSh/internal/domain/models"
+	"urlSh/internal/storage"
+)
+
+// Storage hands out the links due for a check and stores the outcome.
+type Storage interface {
+	// ClaimCheckTargets claims up to limit links last checked before
+	// checkedBefore until leaseUntil, so that other replicas skip them.
+	ClaimCheckTargets(ctx context.Context, checkedBefore, leaseUntil time.Time, limit int) ([]models.Link, error)
+	// SetHealth stores h on the link unless its destination is no longer url.
+	SetHealth(ctx context.Context, alias, url string, h models.Health) error
+}
+
+// Options controls how often and how politely destinations are checked.
+type Options struct {
+	// Workers is the number of hosts checked at the same time; zero disables checking.
+	Workers int
+	// Interval is how long a check stays fresh before the link is checked again.
+	Interval time.Duration
+	// Tick is how often the storage is asked for links due for a check.
+	Tick time.Duration
+	// BatchSize caps the number of links checked per tick.
+	BatchSize int
+	// Lease is how long claimed links are kept from other replicas; it
+	// should cover checking a whole batch.
+	Lease time.Duration
+	// HostDelay is the pause between two requests to the same host.
+	HostDelay time.Duration
+	// BrokenAfter is the number of consecutive failures after which a link is flagged as broken.
+	BrokenAfter int
+	UserAgent   string
+}
+
+type Checker struct {
+	log     *slog.Logger
+	storage Storage
+	client  *http.Client
+	opts    Options
+}
+
+// New returns a checker sending its requests with client, which bounds their
+// duration and decides which addresses may be reached.
+func New(log *slog.Logger, storage Storage, client *http.Client, opts Options) *Checker {
+	return &Checker{log: log, storage: storage, client: client, opts: opts}
+}
+
+// Run checks the links due for a check every opts.Tick until ctx is done.
+func (c *Checker) Run(ctx context.Context) {
+	if c.opts.Workers <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(c.opts.Tick)
+	defer ticker.Stop()
+	for {
+		if err := c.CheckDue(ctx); err != nil && ctx.Err() == nil {
+			c.log.Error("failed to check destinations", slog.String("err", err.Error()))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// CheckDue claims and checks up to opts.BatchSize links last checked more
+// than opts.Interval ago. Hosts are checked concurrently by opts.Workers
+// workers, the links of one host one after another, opts.HostDelay apart.
+func (c *Checker) CheckDue(ctx context.Context) error {
+	now := time.Now()
+	links, err := c.storage.ClaimCheckTargets(ctx, now.Add(-c.opts.Interval), now.Add(c.opts.Lease), c.opts.BatchSize)
+	if err != nil {
+		return err
+	}
+
+	hosts := make(chan []models.Link)
+	var wg sync.WaitGroup
+	for i := 0; i < max(c.opts.Workers, 1); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for group := range hosts {
+				c.checkHost(ctx, group)
+			}
+		}()
+	}
+
+	for _, group := range byHost(links) {
+		select {
+		case hosts <- group:
+		case <-ctx.Done():
+		}
+	}
+	close(hosts)
+	wg.Wait()
+
+	return ctx.Err()
+}
+
+func (c *Checker) checkHost(ctx context.Context, links []models.Link) {
+	for i, link := range links {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(c.opts.HostDelay):
+			}
+		}
+
+		h := c.next(link.Health, c.check(ctx, link.URL))
+		if h.Broken && !link.Health.Broken {
+			c.log.Warn("destination is broken", slog.String("alias", link.Alias), slog.Int("failures", h.Failures))
+		}
+		if err := c.storage.SetHealth(ctx, link.Alias, link.URL, h); err != nil && !errors.Is(err, storage.ErrURLNotFound) {
+			c.log.Error("failed to store destination health", slog.String("alias", link.Alias), slog.String("err", err.Error()))
+		}
+	}
+}
+
+// check requests dest with HEAD, falling back to GET for servers that do not
+// support it, and returns the outcome of this check alone.
+func (c *Checker) check(ctx context.Context, dest string) models.Health {
+	start := time.Now()
+	status, err := c.request(ctx, http.MethodHead, dest)
+	if err == nil && (status == http.StatusMethodNotAllowed || status == http.StatusNotImplemented) {
+		start = time.Now()
+		status, err = c.request(ctx, http.MethodGet, dest)
+	}
+
+	h := models.Health{Status: status, Latency: time.Since(start), CheckedAt: time.Now().UTC()}
+	if err != nil {
+		h.Error = err.Error()
+	}
+	return h
+}
+
+// request sends one request and returns the status of the final response. The
+// body is not read.
+func (c *Checker) request(ctx context.Context, method, dest string) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, method, dest, nil)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("User-Agent", c.opts.UserAgent)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	resp.Body.Close()
+
+	return resp.StatusCode, nil
+}
+
+// next folds the outcome of a check into the previous health of the link.
+func (c *Checker) next(prev, check models.Health) models.Health {
+	h := check
+	h.LastHealthyAt = prev.LastHealthyAt
+	if check.Healthy() {
+		h.LastHealthyAt = check.CheckedAt
+	} else {
+		h.Failures = prev.Failures + 1
+	}
+	h.Broken = c.opts.BrokenAfter > 0 && h.Failures >= c.opts.BrokenAfter
+	return h
+}
+
+// byHost groups links by the host of their destination, keeping their order.
+func byHost(links []models.Link) [][]models.Link {
+	index := make(map[string]int)
+	var groups [][]models.Link
+	for _, link := range links {
+		host := link.URL
+		if u, err := url.Parse(link.URL); err == nil {
+			host = strings.ToLower(u.Host)
+		}
+		i, ok := index[host]
+		if !ok {
+			i = len(groups)
+			index[host] = i
+			groups = append(groups, nil)
+		}
+		groups[i] = append(groups[i], link)
+	}
+	return groups
+}
@@ -0,0 +1,225 @@
+package health
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+	"urlSh/internal/domain/models"
+)
+
+type fakeStorage struct {
+	mu      sync.Mutex
+	links   []models.Link
+	claimed int
+	health  map[string]models.Health
+}
+
+func (s *fakeStorage) ClaimCheckTargets(_ context.Context, _, _ time.Time, limit int) ([]models.Link, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	n := min(limit, len(s.links)-s.claimed)
+	links := s.links[s.claimed : s.claimed+n]
+	s.claimed += n
+	return links, nil
+}
+
+func (s *fakeStorage) SetHealth(_ context.Context, alias, _ string, h models.Health) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.health[alias] = h
+	return nil
+}
+
+func newChecker(storage Storage, client *http.Client) *Checker {
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	return New(log, storage, client, Options{
+		Workers:     4,
+		Interval:    time.Hour,
+		BatchSize:   10,
+		HostDelay:   time.Millisecond,
+		BrokenAfter: 2,
+		UserAgent:   "test",
+	})
+}
+
+func TestCheckDue(t *testing.T) {
+	var mu sync.Mutex
+	var inFlight, maxInFlight int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inFlight++
+		maxInFlight = max(maxInFlight, inFlight)
+		mu.Unlock()
+		defer func() {
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+		}()
+		time.Sleep(5 * time.Millisecond)
+
+		switch r.URL.Path {
+		case "/ok":
+			w.WriteHeader(http.StatusOK)
+		case "/get-only":
+			if r.Method == http.MethodHead {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		case "/moved":
+			http.Redirect(w, r, "/ok", http.StatusFound)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	lastHealthy := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	storage := &fakeStorage{
+		health: make(map[string]models.Health),
+		links: []models.Link{
+			{Alias: "ok", URL: srv.URL + "/ok"},
+			{Alias: "get-only", URL: srv.URL + "/get-only"},
+			{Alias: "moved", URL: srv.URL + "/moved"},
+			{Alias: "missing", URL: srv.URL + "/missing"},
+			{Alias: "still-missing", URL: srv.URL + "/missing", Health: models.Health{Status: 404, Failures: 1, LastHealthyAt: lastHealthy}},
+		},
+	}
+
+	if err := newChecker(storage, srv.Client()).CheckDue(context.Background()); err != nil {
+		t.Fatalf("CheckDue: %v", err)
+	}
+
+	tests := []struct {
+		alias       string
+		status      int
+		failures    int
+		broken      bool
+		lastHealthy bool
+	}{
+		{alias: "ok", status: http.StatusOK, lastHealthy: true},
+		{alias: "get-only", status: http.StatusNoContent, lastHealthy: true},
+		{alias: "moved", status: http.StatusOK, lastHealthy: true},
+		{alias: "missing", status: http.StatusNotFound, failures: 1},
+		{alias: "still-missing", status: http.StatusNotFound, failures: 2, broken: true},
+	}
+	for _, tt := range tests {
+		h, ok := storage.health[tt.alias]
+		if !ok {
+			t.Errorf("%s: not checked", tt.alias)
+			continue
+		}
+		if h.Status != tt.status || h.Failures != tt.failures || h.Broken != tt.broken {
+			t.Errorf("%s: got status %d, failures %d, broken %v; want %d, %d, %v",
+				tt.alias, h.Status, h.Failures, h.Broken, tt.status, tt.failures, tt.broken)
+		}
+		if h.CheckedAt.IsZero() {
+			t.Errorf("%s: CheckedAt not set", tt.alias)
+		}
+		if tt.lastHealthy && !h.LastHealthyAt.Equal(h.CheckedAt) {
+			t.Errorf("%s: LastHealthyAt = %v, want %v", tt.alias, h.LastHealthyAt, h.CheckedAt)
+		}
+	}
+	if h := storage.health["still-missing"]; !h.LastHealthyAt.Equal(lastHealthy) {
+		t.Errorf("still-missing: LastHealthyAt = %v, want %v kept", h.LastHealthyAt, lastHealthy)
+	}
+
+	// All links share the host of the test server.
+	if maxInFlight != 1 {
+		t.Errorf("%d requests to the same host at once, want 1", maxInFlight)
+	}
+}
+
+func TestCheckUnreachable(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	dest := srv.URL
+	srv.Close()
+
+	storage := &fakeStorage{
+		health: make(map[string]models.Health),
+		links:  []models.Link{{Alias: "gone", URL: dest}},
+	}
+	if err := newChecker(storage, http.DefaultClient).CheckDue(context.Background()); err != nil {
+		t.Fatalf("CheckDue: %v", err)
+	}
+
+	h := storage.health["gone"]
+	if h.Status != 0 || h.Error == "" || h.Failures != 1 {
+		t.Errorf("got status %d, error %q, failures %d; want no status, an error and 1 failure", h.Status, h.Error, h.Failures)
+	}
+}
+
+func TestNext(t *testing.T) {
+	t0 := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	t1 := t0.Add(time.Hour)
+	c := &Checker{opts: Options{BrokenAfter: 3}}
+
+	tests := []struct {
+		name  string
+		prev  models.Health
+		check models.Health
+		want  models.Health
+	}{
+		{
+			name:  "first success",
+			check: models.Health{Status: 200, CheckedAt: t1},
+			want:  models.Health{Status: 200, CheckedAt: t1, LastHealthyAt: t1},
+		},
+		{
+			name:  "first failure",
+			check: models.Health{Status: 500, CheckedAt: t1},
+			want:  models.Health{Status: 500, CheckedAt: t1, Failures: 1},
+		},
+		{
+			name:  "failure keeps last healthy",
+			prev:  models.Health{Status: 404, Failures: 1, LastHealthyAt: t0},
+			check: models.Health{Status: 404, CheckedAt: t1},
+			want:  models.Health{Status: 404, CheckedAt: t1, Failures: 2, LastHealthyAt: t0},
+		},
+		{
+			name:  "broken at threshold",
+			prev:  models.Health{Error: "timeout", Failures: 2, LastHealthyAt: t0},
+			check: models.Health{Error: "timeout", CheckedAt: t1},
+			want:  models.Health{Error: "timeout", CheckedAt: t1, Failures: 3, LastHealthyAt: t0, Broken: true},
+		},
+		{
+			name:  "success resets failures",
+			prev:  models.Health{Status: 502, Failures: 5, Broken: true, LastHealthyAt: t0},
+			check: models.Health{Status: 301, CheckedAt: t1},
+			want:  models.Health{Status: 301, CheckedAt: t1, LastHealthyAt: t1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := c.next(tt.prev, tt.check); got != tt.want {
+				t.Errorf("next() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestByHost(t *testing.T) {
+	links := []models.Link{
+		{Alias: "a", URL: "https://example.com/1"},
+		{Alias: "b", URL: "https://other.org/"},
+		{Alias: "c", URL: "https://EXAMPLE.com/2"},
+	}
+
+	groups := byHost(links)
+	if len(groups) != 2 {
+		t.Fatalf("got %d groups, want 2", len(groups))
+	}
+	if len(groups[0]) != 2 || groups[0][0].Alias != "a" || groups[0][1].Alias != "c" {
+		t.Errorf("first group = %v, want a and c in order", groups[0])
+	}
+	if len(groups[1]) != 1 || groups[1][0].Alias != "b" {
+		t.Errorf("second group = %v, want b", groups[1])
+	}
+}
@@ -56,7 +56,7 @@ func (p *Producer) RunProducing(ctx context.Context, wg *sync.WaitGroup) {
 		case <-ctx.Done():
 			return
 		case message := <-p.ch:
-			p.log.Info("trying to produce message", message)
+			p.log.Info("trying to produce message", slog.Any("message", message))
 			jsonData, err := json.Marshal(message)
 			if err != nil {
 				p.log.Error("Failed to marshal message:", slog.String("err", err.Error()))
@@ -0,0 +1,169 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"math/bits"
+)
+
+const base62Alphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZ" +
+	"abcdefghijklmnopqrstuvwxyz" +
+	"0123456789"
+
+const (
+	StrategyRandom  = "random"
+	StrategyCounter = "counter"
+	StrategyHashids = "hashids"
+)
+
+// aliasSequence is the name of the counter used by the sequence based strategies.
+const aliasSequence = "alias"
+
+// AliasGenerator produces candidate aliases for new short links.
+// Candidates are not guaranteed to be free: the caller saves them and retries on storage.ErrURLExists.
+type AliasGenerator interface {
+	Generate(ctx context.Context, length int) (string, error)
+}
+
+// SequenceStorage hands out monotonically increasing numbers shared between all replicas.
+type SequenceStorage interface {
+	NextSequence(ctx context.Context, name string) (int64, error)
+}
+
+// NewAliasGenerator returns the generator for the configured strategy.
+func NewAliasGenerator(strategy string, seq SequenceStorage, salt string) (AliasGenerator, error) {
+	switch strategy {
+	case "", StrategyRandom:
+		return RandomAliasGenerator{}, nil
+	case StrategyCounter:
+		return &CounterAliasGenerator{seq: seq}, nil
+	case StrategyHashids:
+		return NewHashidsAliasGenerator(seq, salt), nil
+	default:
+		return nil, fmt.Errorf("unknown alias strategy %q", strategy)
+	}
+}
+
+// RandomAliasGenerator draws every character from crypto/rand.
+type RandomAliasGenerator struct{}
+
+func (RandomAliasGenerator) Generate(_ context.Context, length int) (string, error) {
+	max := big.NewInt(int64(len(base62Alphabet)))
+
+	b := make([]byte, length)
+	for i := range b {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		b[i] = base62Alphabet[n.Int64()]
+	}
+
+	return string(b), nil
+}
+
+// CounterAliasGenerator base62-encodes the next value of a shared sequence,
+// left-padded to the requested length. Aliases are short and never collide
+// with each other, but they are predictable.
+type CounterAliasGenerator struct {
+	seq SequenceStorage
+}
+
+func (g *CounterAliasGenerator) Generate(ctx context.Context, length int) (string, error) {
+	n, err := g.seq.NextSequence(ctx, aliasSequence)
+	if err != nil {
+		return "", err
+	}
+
+	return encodeBase62(base62Alphabet, uint64(n), length), nil
+}
+
+// HashidsAliasGenerator works like CounterAliasGenerator but scrambles the
+// sequence value with a salted bijection and a salted alphabet, so consecutive
+// links do not get guessable neighbouring aliases.
+type HashidsAliasGenerator struct {
+	seq      SequenceStorage
+	alphabet string
+	mult     uint64
+	offset   uint64
+}
+
+// maxScrambleLength is the longest alias whose value space (62^10) still fits into uint64.
+const maxScrambleLength = 10
+
+func NewHashidsAliasGenerator(seq SequenceStorage, salt string) *HashidsAliasGenerator {
+	sum := sha256.Sum256([]byte(salt))
+
+	// The multiplier must be coprime with 62 for the scramble to be a bijection.
+	mult := binary.BigEndian.Uint64(sum[0:8]) | 1
+	for mult%31 == 0 {
+		mult += 2
+	}
+
+	return &HashidsAliasGenerator{
+		seq:      seq,
+		alphabet: shuffleAlphabet(base62Alphabet, sum[:]),
+		mult:     mult,
+		offset:   binary.BigEndian.Uint64(sum[8:16]),
+	}
+}
+
+func (g *HashidsAliasGenerator) Generate(ctx context.Context, length int) (string, error) {
+	n, err := g.seq.NextSequence(ctx, aliasSequence)
+	if err != nil {
+		return "", err
+	}
+
+	size := min(length, maxScrambleLength)
+	space := pow62(size)
+	for uint64(n) >= space && size < maxScrambleLength {
+		size++
+		space = pow62(size)
+	}
+
+	hi, lo := bits.Mul64(uint64(n)%space, g.mult%space)
+	scrambled := (bits.Rem64(hi, lo, space) + g.offset%space) % space
+
+	return encodeBase62(g.alphabet, scrambled, max(length, size)), nil
+}
+
+func encodeBase62(alphabet string, n uint64, length int) string {
+	var b []byte
+	for n > 0 {
+		b = append(b, alphabet[n%62])
+		n /= 62
+	}
+	for len(b) < length {
+		b = append(b, alphabet[0])
+	}
+	for i, j := 0, len(b)-1; i < j; i, j = i+1, j-1 {
+		b[i], b[j] = b[j], b[i]
+	}
+
+	return string(b)
+}
+
+func pow62(n int) uint64 {
+	p := uint64(1)
+	for i := 0; i < n; i++ {
+		p *= 62
+	}
+	return p
+}
+
+// shuffleAlphabet deterministically permutes the alphabet with the salt, the way hashids does.
+func shuffleAlphabet(alphabet string, salt []byte) string {
+	b := []byte(alphabet)
+	for i, v, p := len(b)-1, 0, 0; i > 0; i-- {
+		v %= len(salt)
+		p += int(salt[v])
+		j := (int(salt[v]) + v + p) % i
+		b[i], b[j] = b[j], b[i]
+		v++
+	}
+	return string(b)
+}
@@ -0,0 +1,125 @@
+package services
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+// fakeSequence counts from start, like the counters collection.
+type fakeSequence struct {
+	next int64
+}
+
+func (s *fakeSequence) NextSequence(context.Context, string) (int64, error) {
+	n := s.next
+	s.next++
+	return n, nil
+}
+
+func TestEncodeBase62(t *testing.T) {
+	tests := []struct {
+		n      uint64
+		length int
+		want   string
+	}{
+		{0, 0, ""},
+		{0, 3, "AAA"},
+		{1, 3, "AAB"},
+		{61, 1, "9"},
+		{62, 1, "BA"},
+		{62*62 - 1, 2, "99"},
+		{62 * 62, 2, "BAA"},
+	}
+	for _, tt := range tests {
+		if got := encodeBase62(base62Alphabet, tt.n, tt.length); got != tt.want {
+			t.Errorf("encodeBase62(%d, %d) = %q, want %q", tt.n, tt.length, got, tt.want)
+		}
+	}
+}
+
+func TestNewAliasGenerator(t *testing.T) {
+	for _, strategy := range []string{"", StrategyRandom, StrategyCounter, StrategyHashids} {
+		if _, err := NewAliasGenerator(strategy, &fakeSequence{}, "salt"); err != nil {
+			t.Errorf("NewAliasGenerator(%q): %v", strategy, err)
+		}
+	}
+	if _, err := NewAliasGenerator("sequential", &fakeSequence{}, "salt"); err == nil {
+		t.Error("NewAliasGenerator accepted an unknown strategy")
+	}
+}
+
+func TestRandomAliasGenerator(t *testing.T) {
+	seen := make(map[string]bool)
+	for i := 0; i < 100; i++ {
+		alias, err := RandomAliasGenerator{}.Generate(context.Background(), 8)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(alias) != 8 || strings.Trim(alias, base62Alphabet) != "" {
+			t.Fatalf("got %q, want 8 base62 characters", alias)
+		}
+		seen[alias] = true
+	}
+	if len(seen) < 100 {
+		t.Errorf("%d distinct aliases out of 100", len(seen))
+	}
+}
+
+func TestCounterAliasGenerator(t *testing.T) {
+	g := &CounterAliasGenerator{seq: &fakeSequence{next: 61}}
+	for _, want := range []string{"AAA9", "AABA", "AABB"} {
+		got, err := g.Generate(context.Background(), 4)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("Generate() = %q, want %q", got, want)
+		}
+	}
+}
+
+func TestHashidsAliasGenerator(t *testing.T) {
+	ctx := context.Background()
+
+	// Every value of a 2 character space maps to a distinct alias of that length.
+	g := NewHashidsAliasGenerator(&fakeSequence{}, "salt")
+	seen := make(map[string]bool)
+	for i := 0; i < 62*62; i++ {
+		alias, err := g.Generate(ctx, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(alias) != 2 {
+			t.Fatalf("value %d: got %q, want 2 characters", i, alias)
+		}
+		if seen[alias] {
+			t.Fatalf("value %d: %q generated twice", i, alias)
+		}
+		seen[alias] = true
+	}
+
+	// Once the space is used up the aliases grow.
+	alias, err := g.Generate(ctx, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(alias) != 3 {
+		t.Errorf("after the 2 character space: got %q, want 3 characters", alias)
+	}
+
+	// The same salt gives the same aliases, another salt different ones.
+	first := func(salt string) string {
+		alias, err := NewHashidsAliasGenerator(&fakeSequence{next: 1}, salt).Generate(ctx, 6)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return alias
+	}
+	if first("salt") != first("salt") {
+		t.Error("same salt gave different aliases")
+	}
+	if first("salt") == first("pepper") {
+		t.Error("different salts gave the same alias")
+	}
+}
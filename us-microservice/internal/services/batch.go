@@ -0,0 +1,127 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+	"urlSh/internal/domain/models"
+	"urlSh/internal/storage"
+)
+
+var ErrBatchTooLarge = errors.New("too many links in one batch")
+
+// BatchResult is the outcome of one link of a ShortenUrls call.
+type BatchResult struct {
+	Alias string
+	Err   error
+}
+
+// ShortenUrls stores many links at once. Every link succeeds or fails on its
+// own: the returned slice has one result per input link, in the same order.
+// The error is only set when the batch as a whole was rejected.
+func (u *URLShortener) ShortenUrls(ctx context.Context, links []models.Link) ([]BatchResult, error) {
+	u.log.Info("attempting to shorten URL batch", slog.Int("size", len(links)))
+	if u.opts.MaxBatchSize > 0 && len(links) > u.opts.MaxBatchSize {
+		return nil, fmt.Errorf("%w: at most %d are allowed", ErrBatchTooLarge, u.opts.MaxBatchSize)
+	}
+
+	results := make([]BatchResult, len(links))
+
+	// pending holds the indexes of links that still have to be saved.
+	var pending []int
+	generated := make(map[int]bool)
+	for i, link := range links {
+		link, err := u.prepareLink(ctx, link)
+		if err == nil && link.Alias != "" {
+			err = u.validateAlias(link.Alias)
+		}
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+		links[i] = link
+		generated[i] = link.Alias == ""
+		pending = append(pending, i)
+	}
+
+	for attempt := 0; len(pending) > 0 && attempt < u.opts.Alias.MaxAttempts; attempt++ {
+		batch := make([]models.Link, 0, len(pending))
+		for _, i := range pending {
+			if generated[i] {
+				alias, err := u.generateAlias(ctx)
+				if err != nil {
+					return nil, err
+				}
+				links[i].Alias = alias
+			}
+			batch = append(batch, links[i])
+		}
+
+		errs := u.storage.SaveURLs(ctx, batch)
+
+		var retry []int
+		for j, i := range pending {
+			err := errs[j]
+			if err == nil {
+				results[i] = BatchResult{Alias: links[i].Alias}
+				continue
+			}
+			if generated[i] && errors.Is(err, storage.ErrURLExists) {
+				u.recordCollision()
+				retry = append(retry, i)
+			}
+			results[i].Err = err
+		}
+		pending = retry
+	}
+	for _, i := range pending {
+		results[i].Err = ErrAliasSpaceExhausted
+	}
+
+	u.cacheBatch(ctx, links, results)
+
+	for i, res := range results {
+		if res.Err == nil {
+			u.enricher.Enqueue(res.Alias, links[i].URL)
+			u.kafkaCh <- models.Url{UrlText: res.Alias, UserId: links[i].UserId}
+		}
+	}
+
+	return results, nil
+}
+
+// cacheBatch writes the destinations of the saved links to the cache in one round trip.
+// A failing cache only costs a storage lookup on the first redirect, so the
+// links are still reported as created; cache errors are logged. Click-limited
+// links whose counter cannot be reset fail as they do in ShortenUrl, since they
+// could inherit the clicks of a purged link with the same alias.
+func (u *URLShortener) cacheBatch(ctx context.Context, links []models.Link, results []BatchResult) {
+	now := time.Now()
+
+	var entries []models.CacheEntry
+	for i, res := range results {
+		if res.Err != nil {
+			continue
+		}
+		link := links[i]
+		if link.MaxClicks > 0 {
+			if err := u.cache.ResetClicks(ctx, link.Alias); err != nil {
+				u.log.Error("failed to reset click counter", slog.String("alias", link.Alias), slog.String("err", err.Error()))
+				results[i].Err = err
+				continue
+			}
+		}
+		if cacheable(link, now) {
+			entries = append(entries, models.CacheEntry{Alias: link.Alias, URL: link.URL, Expiration: u.cacheTTL(link)})
+		}
+	}
+	if len(entries) == 0 {
+		return
+	}
+
+	if err := u.cache.SaveURLs(ctx, entries); err != nil {
+		u.log.Error("failed to cache url batch", slog.String("err", err.Error()))
+	}
+}
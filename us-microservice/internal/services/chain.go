@@ -0,0 +1,95 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+	"urlSh/internal/storage"
+)
+
+var (
+	ErrShortenerChain = fmt.Errorf("%w: original_url points to another URL shortener", ErrInvalidLink)
+	ErrSelfLink       = fmt.Errorf("%w: original_url points to a short link of this service", ErrInvalidLink)
+	ErrRedirectLoop   = fmt.Errorf("%w: original_url leads to a redirect loop", ErrInvalidLink)
+)
+
+// ChainOptions controls how destinations on URL shorteners are handled.
+type ChainOptions struct {
+	// ShortDomains are the hosts, with port if not the default, our own short links are served on.
+	ShortDomains []string
+	// Resolve replaces a destination on ShortDomains with the final target of
+	// that short link. When false such destinations are rejected.
+	Resolve bool
+	// MaxDepth bounds the number of short links followed while resolving.
+	MaxDepth int
+	// ShortenerHosts are third-party shorteners; their subdomains match as well.
+	ShortenerHosts []string
+}
+
+// resolveChain rejects destinations on third-party shorteners and follows
+// destinations on our own short domains to the URL they finally redirect to,
+// so a redirect never leads to another short link.
+func (u *URLShortener) resolveChain(ctx context.Context, dest string) (string, error) {
+	seen := make(map[string]bool)
+
+	for depth := 0; ; depth++ {
+		target, err := url.Parse(dest)
+		if err != nil || target.Host == "" {
+			return dest, nil
+		}
+		if matchHost(target.Hostname(), u.opts.Chain.ShortenerHosts) {
+			return "", ErrShortenerChain
+		}
+		if !u.ownDomain(target.Host) {
+			return dest, nil
+		}
+		if !u.opts.Chain.Resolve {
+			return "", ErrSelfLink
+		}
+
+		alias := strings.Trim(target.Path, "/")
+		if seen[alias] || depth >= u.opts.Chain.MaxDepth {
+			return "", ErrRedirectLoop
+		}
+		seen[alias] = true
+
+		link, err := u.storage.GetURL(ctx, alias)
+		if errors.Is(err, storage.ErrURLNotFound) {
+			return "", fmt.Errorf("%w: /%s does not exist", ErrSelfLink, alias)
+		}
+		if err != nil {
+			return "", err
+		}
+		// Resolving would let visitors skip the access rules of the target link.
+		if link.Protected() || link.MaxClicks > 0 || len(link.Rules) > 0 || len(link.Variants) > 0 || link.DeepLink.Enabled() ||
+			available(link, time.Now()) != nil {
+			return "", fmt.Errorf("%w: /%s cannot be resolved", ErrSelfLink, alias)
+		}
+
+		dest = link.URL
+	}
+}
+
+func (u *URLShortener) ownDomain(host string) bool {
+	for _, d := range u.opts.Chain.ShortDomains {
+		if strings.EqualFold(host, d) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchHost reports whether host is one of hosts or a subdomain of one of them.
+func matchHost(host string, hosts []string) bool {
+	host = strings.ToLower(host)
+	for _, h := range hosts {
+		h = strings.ToLower(h)
+		if host == h || strings.HasSuffix(host, "."+h) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,82 @@
+package services
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+	"urlSh/internal/domain/models"
+)
+
+var ErrInvalidDeepLink = fmt.Errorf("%w: invalid deep link", ErrInvalidLink)
+
+// maxAppURI bounds the length of app URIs.
+const maxAppURI = 2048
+
+// unsafeSchemes run code or read local data in the browser instead of opening an app.
+var unsafeSchemes = []string{"javascript", "vbscript", "data", "file", "blob", "about"}
+
+// prepareDeepLink validates the app URIs and normalizes the fallback the same
+// way as the main destination.
+func (u *URLShortener) prepareDeepLink(d models.DeepLink) (models.DeepLink, error) {
+	if !d.Enabled() {
+		if d.Fallback != "" {
+			return d, fmt.Errorf("%w: fallback needs an ios or android app URI", ErrInvalidDeepLink)
+		}
+		return d, nil
+	}
+
+	for name, uri := range map[string]*string{"ios": &d.IOS, "android": &d.Android} {
+		*uri = strings.TrimSpace(*uri)
+		if *uri == "" {
+			continue
+		}
+		if err := validateAppURI(*uri); err != nil {
+			return d, fmt.Errorf("%w: %s %s", ErrInvalidDeepLink, name, err)
+		}
+	}
+
+	if d.Fallback != "" {
+		var err error
+		if d.Fallback, err = normalizeURL(d.Fallback, u.opts.URL); err != nil {
+			return d, fmt.Errorf("%w: fallback is not a valid web URL", ErrInvalidDeepLink)
+		}
+		if err = u.checkBlocked(d.Fallback); err != nil {
+			return d, err
+		}
+	}
+
+	return d, nil
+}
+
+func validateAppURI(uri string) error {
+	if len(uri) > maxAppURI {
+		return fmt.Errorf("must be at most %d characters", maxAppURI)
+	}
+	if strings.ContainsFunc(uri, func(r rune) bool { return r <= ' ' || r == 0x7f }) {
+		return fmt.Errorf("must not contain whitespace or control characters")
+	}
+	parsed, err := url.Parse(uri)
+	if err != nil || parsed.Scheme == "" {
+		return fmt.Errorf("must be an absolute URI such as myapp://path")
+	}
+	if slices.Contains(unsafeSchemes, strings.ToLower(parsed.Scheme)) {
+		return fmt.Errorf("must not use the %s scheme", parsed.Scheme)
+	}
+	return nil
+}
+
+// appURL returns the app URI of the deep link for the visitor's platform, or "".
+func appURL(d models.DeepLink, visitor models.Visitor) string {
+	if !d.Enabled() {
+		return ""
+	}
+	switch detectOS(visitor.UserAgent) {
+	case "ios":
+		return d.IOS
+	case "android":
+		return d.Android
+	default:
+		return ""
+	}
+}
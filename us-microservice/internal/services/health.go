@@ -0,0 +1,21 @@
+package services
+
+import (
+	"context"
+	"urlSh/internal/domain/models"
+	"urlSh/internal/storage"
+)
+
+// GetUrlHealth returns the outcome of the latest checks of the destination of
+// a link owned by userId. It is zero until the first check.
+func (u *URLShortener) GetUrlHealth(ctx context.Context, shortURL string, userId int64) (models.Health, error) {
+	link, err := u.storage.GetURL(ctx, shortURL)
+	if err != nil {
+		return models.Health{}, err
+	}
+	if link.UserId != userId {
+		return models.Health{}, storage.ErrNotOwner
+	}
+
+	return link.Health, nil
+}
@@ -0,0 +1,129 @@
+package services
+
+import (
+	"fmt"
+	"golang.org/x/net/idna"
+	"net"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// ErrInvalidURL is wrapped by every rejection of a destination URL.
+var ErrInvalidURL = fmt.Errorf("%w: original_url", ErrInvalidLink)
+
+// URLOptions configures how destination URLs are validated and normalized.
+type URLOptions struct {
+	// Schemes lists the allowed schemes, lowercase.
+	Schemes []string
+	// StripTracking removes the query parameters listed in TrackingParams.
+	StripTracking bool
+	// TrackingParams are parameter names; a trailing '*' matches any suffix, e.g. "utm_*".
+	TrackingParams []string
+}
+
+// defaultPorts also lists the hierarchical schemes, which must have a host.
+var defaultPorts = map[string]string{
+	"http":  "80",
+	"https": "443",
+	"ftp":   "21",
+}
+
+// normalizeURL validates a destination URL and returns its canonical form:
+// lowercase scheme and host, IDN hosts in punycode, no default port and,
+// optionally, no tracking parameters.
+func normalizeURL(raw string, opts URLOptions) (string, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return "", fmt.Errorf("%w is required", ErrInvalidURL)
+	}
+	if strings.ContainsFunc(raw, func(r rune) bool { return r <= ' ' || r == 0x7f }) {
+		return "", fmt.Errorf("%w must not contain whitespace or control characters", ErrInvalidURL)
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", fmt.Errorf("%w is not a valid URL", ErrInvalidURL)
+	}
+	if u.Scheme == "" {
+		return "", fmt.Errorf("%w must be an absolute URL", ErrInvalidURL)
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+	if !slices.Contains(opts.Schemes, u.Scheme) {
+		return "", fmt.Errorf("%w: scheme %q is not allowed", ErrInvalidURL, u.Scheme)
+	}
+
+	// Opaque URLs such as mailto: have no host to normalize. A browser reads
+	// "http:evil.example" as "http://evil.example", so hierarchical schemes
+	// fall through to the host check instead.
+	if _, hierarchical := defaultPorts[u.Scheme]; u.Opaque != "" && !hierarchical {
+		return u.String(), nil
+	}
+
+	host, err := normalizeHost(u.Hostname())
+	if err != nil {
+		return "", err
+	}
+	if port := u.Port(); port != "" && port != defaultPorts[u.Scheme] {
+		host = net.JoinHostPort(strings.Trim(host, "[]"), port)
+	}
+	u.Host = host
+
+	if opts.StripTracking {
+		u.RawQuery = stripParams(u.RawQuery, opts.TrackingParams)
+	}
+
+	return u.String(), nil
+}
+
+// normalizeHost lowercases the host and converts internationalized names to punycode.
+func normalizeHost(host string) (string, error) {
+	if host == "" {
+		return "", fmt.Errorf("%w must have a host", ErrInvalidURL)
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		if ip.To4() == nil {
+			return "[" + ip.String() + "]", nil
+		}
+		return ip.String(), nil
+	}
+
+	ascii, err := idna.Lookup.ToASCII(strings.TrimSuffix(host, "."))
+	if err != nil {
+		return "", fmt.Errorf("%w: invalid host %q", ErrInvalidURL, host)
+	}
+	return strings.ToLower(ascii), nil
+}
+
+// stripParams drops the matching parameters from a raw query. The remaining
+// parameters keep their order and encoding.
+func stripParams(rawQuery string, params []string) string {
+	if rawQuery == "" || len(params) == 0 {
+		return rawQuery
+	}
+
+	kept := make([]string, 0)
+	for _, pair := range strings.Split(rawQuery, "&") {
+		key, _, _ := strings.Cut(pair, "=")
+		if name, err := url.QueryUnescape(key); err == nil && matchParam(name, params) {
+			continue
+		}
+		kept = append(kept, pair)
+	}
+	return strings.Join(kept, "&")
+}
+
+func matchParam(name string, params []string) bool {
+	name = strings.ToLower(name)
+	for _, p := range params {
+		if prefix, ok := strings.CutSuffix(p, "*"); ok {
+			if strings.HasPrefix(name, prefix) {
+				return true
+			}
+		} else if name == p {
+			return true
+		}
+	}
+	return false
+}
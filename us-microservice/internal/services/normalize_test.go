@@ -0,0 +1,73 @@
+package services
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNormalizeURL(t *testing.T) {
+	opts := URLOptions{Schemes: []string{"http", "https", "mailto"}}
+	stripping := URLOptions{
+		Schemes:        []string{"http", "https"},
+		StripTracking:  true,
+		TrackingParams: []string{"utm_*", "fbclid"},
+	}
+
+	tests := []struct {
+		name string
+		raw  string
+		opts URLOptions
+		want string
+	}{
+		{"unchanged", "https://example.com/a?b=c#d", opts, "https://example.com/a?b=c#d"},
+		{"trimmed", "  https://example.com/  ", opts, "https://example.com/"},
+		{"lowercase scheme and host", "HTTPS://Example.COM/Path", opts, "https://example.com/Path"},
+		{"default port dropped", "http://example.com:80/", opts, "http://example.com/"},
+		{"https default port dropped", "https://example.com:443/", opts, "https://example.com/"},
+		{"other port kept", "https://example.com:8443/", opts, "https://example.com:8443/"},
+		{"trailing dot dropped", "https://example.com./", opts, "https://example.com/"},
+		{"idn to punycode", "https://bücher.de/", opts, "https://xn--bcher-kva.de/"},
+		{"ipv4", "http://192.168.0.1:8080/", opts, "http://192.168.0.1:8080/"},
+		{"ipv6", "http://[2001:DB8::1]:443/", opts, "http://[2001:db8::1]:443/"},
+		{"opaque", "mailto:someone@example.com", opts, "mailto:someone@example.com"},
+		{"tracking kept by default", "https://example.com/?utm_source=x&a=1", opts, "https://example.com/?utm_source=x&a=1"},
+		{"tracking stripped", "https://example.com/?utm_source=x&a=1&UTM_Medium=y&fbclid=z", stripping, "https://example.com/?a=1"},
+		{"encoded names stripped", "https://example.com/?utm%5Fsource=x&b=%20", stripping, "https://example.com/?b=%20"},
+		{"only tracking", "https://example.com/?fbclid=z", stripping, "https://example.com/"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := normalizeURL(tt.raw, tt.opts)
+			if err != nil {
+				t.Fatalf("normalizeURL(%q): %v", tt.raw, err)
+			}
+			if got != tt.want {
+				t.Errorf("normalizeURL(%q) = %q, want %q", tt.raw, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeURLInvalid(t *testing.T) {
+	opts := URLOptions{Schemes: []string{"http", "https"}}
+
+	for _, raw := range []string{
+		"",
+		"   ",
+		"example.com/path",
+		"/relative",
+		"https://exa mple.com/",
+		"https://example.com/\x00",
+		"javascript:alert(1)",
+		"ftp://example.com/",
+		"https:///path",
+		"http:evil.example/login",
+		"HTTPS:evil.example",
+		"http://%zz/",
+		"https://xn--a.com/",
+	} {
+		if got, err := normalizeURL(raw, opts); !errors.Is(err, ErrInvalidURL) {
+			t.Errorf("normalizeURL(%q) = %q, %v; want %v", raw, got, err, ErrInvalidURL)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"urlSh/internal/domain/models"
+)
+
+var (
+	ErrInvalidPassthrough = fmt.Errorf("%w: passthrough conflict must be %q, %q or %q", ErrInvalidLink,
+		models.ConflictKeepDestination, models.ConflictOverride, models.ConflictAppend)
+	// ErrInvalidSuffix rejects request paths that would climb out of the destination path.
+	ErrInvalidSuffix = errors.New("invalid path suffix")
+)
+
+func validatePassthrough(p models.Passthrough) (models.Passthrough, error) {
+	switch p.Conflict {
+	case "":
+		p.Conflict = models.ConflictKeepDestination
+	case models.ConflictKeepDestination, models.ConflictOverride, models.ConflictAppend:
+	default:
+		return p, ErrInvalidPassthrough
+	}
+	return p, nil
+}
+
+// passthrough appends the path suffix and merges the query of the request
+// into the destination, as far as the link allows it.
+func passthrough(dest string, p models.Passthrough, suffix, rawQuery string) (string, error) {
+	if !p.Path && !p.Query || suffix == "" && rawQuery == "" {
+		return dest, nil
+	}
+
+	u, err := url.Parse(dest)
+	if err != nil {
+		return "", err
+	}
+
+	if p.Path && suffix != "" {
+		for _, segment := range strings.Split(suffix, "/") {
+			if segment == "." || segment == ".." {
+				return "", ErrInvalidSuffix
+			}
+		}
+		u = u.JoinPath(suffix)
+	}
+
+	if p.Query && rawQuery != "" {
+		u.RawQuery = mergeQuery(u.RawQuery, rawQuery, p.Conflict)
+	}
+
+	return u.String(), nil
+}
+
+// mergeQuery adds the request parameters to the destination ones. Parameters
+// keep their order and encoding; conflict decides which side wins for names
+// present in both.
+func mergeQuery(destQuery, reqQuery, conflict string) string {
+	dest := splitQuery(destQuery)
+	req := splitQuery(reqQuery)
+
+	inDest := make(map[string]bool, len(dest))
+	for _, pair := range dest {
+		inDest[queryName(pair)] = true
+	}
+	inReq := make(map[string]bool, len(req))
+	for _, pair := range req {
+		inReq[queryName(pair)] = true
+	}
+
+	merged := make([]string, 0, len(dest)+len(req))
+	for _, pair := range dest {
+		if conflict == models.ConflictOverride && inReq[queryName(pair)] {
+			continue
+		}
+		merged = append(merged, pair)
+	}
+	for _, pair := range req {
+		if conflict == models.ConflictKeepDestination && inDest[queryName(pair)] {
+			continue
+		}
+		merged = append(merged, pair)
+	}
+
+	return strings.Join(merged, "&")
+}
+
+func splitQuery(rawQuery string) []string {
+	var pairs []string
+	for _, pair := range strings.Split(rawQuery, "&") {
+		if pair != "" {
+			pairs = append(pairs, pair)
+		}
+	}
+	return pairs
+}
+
+func queryName(pair string) string {
+	key, _, _ := strings.Cut(pair, "=")
+	if name, err := url.QueryUnescape(key); err == nil {
+		return name
+	}
+	return key
+}
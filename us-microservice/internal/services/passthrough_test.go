@@ -0,0 +1,84 @@
+package services
+
+import (
+	"errors"
+	"testing"
+	"urlSh/internal/domain/models"
+)
+
+func TestPassthrough(t *testing.T) {
+	both := models.Passthrough{Path: true, Query: true, Conflict: models.ConflictKeepDestination}
+
+	tests := []struct {
+		name   string
+		dest   string
+		p      models.Passthrough
+		suffix string
+		query  string
+		want   string
+	}{
+		{"disabled", "https://example.com/base", models.Passthrough{}, "x", "a=1", "https://example.com/base"},
+		{"nothing to pass", "https://example.com/base?a=1", both, "", "", "https://example.com/base?a=1"},
+		{"path", "https://example.com/base", both, "docs/intro", "", "https://example.com/base/docs/intro"},
+		{"path with trailing slash", "https://example.com/base/", both, "docs/", "", "https://example.com/base/docs/"},
+		{"path only", "https://example.com/base", models.Passthrough{Path: true}, "x", "a=1", "https://example.com/base/x"},
+		{"query only", "https://example.com/base", models.Passthrough{Query: true}, "x", "a=1", "https://example.com/base?a=1"},
+		{"query appended", "https://example.com/?a=1", both, "", "b=2", "https://example.com/?a=1&b=2"},
+		{"encoding kept", "https://example.com/?q=a%20b", both, "", "r=c+d&s=%2F", "https://example.com/?q=a%20b&r=c+d&s=%2F"},
+		{"fragment kept", "https://example.com/base#top", both, "x", "a=1", "https://example.com/base/x?a=1#top"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := passthrough(tt.dest, tt.p, tt.suffix, tt.query)
+			if err != nil {
+				t.Fatalf("passthrough: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("passthrough() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPassthroughInvalidSuffix(t *testing.T) {
+	p := models.Passthrough{Path: true}
+
+	for _, suffix := range []string{"..", "../admin", "a/../../b", "./x", "a/."} {
+		if got, err := passthrough("https://example.com/base", p, suffix, ""); !errors.Is(err, ErrInvalidSuffix) {
+			t.Errorf("passthrough(%q) = %q, %v; want %v", suffix, got, err, ErrInvalidSuffix)
+		}
+	}
+}
+
+func TestMergeQuery(t *testing.T) {
+	tests := []struct {
+		conflict string
+		dest     string
+		req      string
+		want     string
+	}{
+		{models.ConflictKeepDestination, "a=1&b=2", "b=3&c=4", "a=1&b=2&c=4"},
+		{models.ConflictOverride, "a=1&b=2", "b=3&c=4", "a=1&b=3&c=4"},
+		{models.ConflictAppend, "a=1&b=2", "b=3&c=4", "a=1&b=2&b=3&c=4"},
+		{models.ConflictKeepDestination, "", "a=1", "a=1"},
+		{models.ConflictOverride, "a=1", "", "a=1"},
+		{models.ConflictKeepDestination, "a%5Fb=1", "a_b=2", "a%5Fb=1"},
+		{models.ConflictOverride, "flag&x=1", "flag=on", "x=1&flag=on"},
+		{models.ConflictAppend, "a=1&&", "&b=2", "a=1&b=2"},
+	}
+	for _, tt := range tests {
+		if got := mergeQuery(tt.dest, tt.req, tt.conflict); got != tt.want {
+			t.Errorf("mergeQuery(%q, %q, %q) = %q, want %q", tt.dest, tt.req, tt.conflict, got, tt.want)
+		}
+	}
+}
+
+func TestValidatePassthrough(t *testing.T) {
+	p, err := validatePassthrough(models.Passthrough{Path: true})
+	if err != nil || p.Conflict != models.ConflictKeepDestination {
+		t.Errorf("validatePassthrough() = %+v, %v; want conflict %q", p, err, models.ConflictKeepDestination)
+	}
+	if _, err := validatePassthrough(models.Passthrough{Conflict: "merge"}); !errors.Is(err, ErrInvalidLink) {
+		t.Errorf("got %v, want %v", err, ErrInvalidLink)
+	}
+}
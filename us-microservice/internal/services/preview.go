@@ -0,0 +1,67 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+	"urlSh/internal/domain/models"
+	"urlSh/internal/storage"
+)
+
+// PreviewUrl describes a link to visitors without redirecting or counting a
+// click. The destination of protected and blocked links and its metadata are left out.
+func (u *URLShortener) PreviewUrl(ctx context.Context, shortURL string) (models.Preview, error) {
+	link, err := u.storage.GetURL(ctx, shortURL)
+	if err != nil {
+		return models.Preview{}, err
+	}
+
+	p := models.Preview{
+		Alias:     link.Alias,
+		URL:       link.URL,
+		Title:     link.Title,
+		CreatedAt: link.CreatedAt,
+		OwnerName: link.OwnerName,
+		Status:    previewStatus(link, time.Now()),
+		Flagged:   u.isFlagged(link.URL),
+		Protected: link.Protected(),
+		Varies: len(link.Rules) > 0 || len(link.Variants) > 0 ||
+			link.Passthrough.Enabled() || link.DeepLink.Enabled(),
+		Page: link.Page,
+	}
+	if _, blocked := u.blocklist.Match(link.URL); blocked {
+		p.Status = models.StatusBlocked
+	}
+	if p.Protected || p.Status == models.StatusBlocked {
+		p.URL, p.Page = "", models.PageMeta{}
+	}
+
+	return p, nil
+}
+
+// previewStatus tells whether the link redirects at the given moment, and why not.
+func previewStatus(link models.Link, now time.Time) string {
+	var notActive *storage.NotYetActiveError
+	err := available(link, now)
+	switch {
+	case err == nil:
+		return models.StatusActive
+	case errors.Is(err, storage.ErrURLDisabled):
+		return models.StatusDisabled
+	case errors.As(err, &notActive):
+		return models.StatusNotYetActive
+	default:
+		return models.StatusExpired
+	}
+}
+
+// isFlagged reports whether the destination is on the flag list, so visitors
+// see the preview page before being sent there.
+func (u *URLShortener) isFlagged(url string) bool {
+	rule, flagged := u.flagged.Match(url)
+	if flagged {
+		u.log.Info("flagged destination", slog.String("url", url), slog.String("rule", rule))
+	}
+	return flagged
+}
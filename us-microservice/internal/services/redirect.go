@@ -0,0 +1,92 @@
+package services
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"urlSh/internal/domain/models"
+)
+
+var ErrInvalidRedirect = fmt.Errorf("%w: invalid redirect", ErrInvalidLink)
+
+// maxHeaderValue bounds the length of the per-link header values.
+const maxHeaderValue = 256
+
+var redirectStatuses = []int{
+	http.StatusMovedPermanently,
+	http.StatusFound,
+	http.StatusTemporaryRedirect,
+	http.StatusPermanentRedirect,
+}
+
+var referrerPolicies = []string{
+	"no-referrer", "no-referrer-when-downgrade", "origin", "origin-when-cross-origin",
+	"same-origin", "strict-origin", "strict-origin-when-cross-origin", "unsafe-url",
+}
+
+// validateRedirect checks the redirect settings of link. A redirect that
+// browsers or shared caches may store would skip the click counting and the
+// per-visitor choice of the destination, so it is only allowed on links that
+// always redirect every visitor to the same URL.
+func validateRedirect(link models.Link) error {
+	r := link.Redirect
+	if r.Status != 0 && !slices.Contains(redirectStatuses, r.Status) {
+		return fmt.Errorf("%w: status must be 301, 302, 307 or 308", ErrInvalidRedirect)
+	}
+	if r.ReferrerPolicy != "" && !slices.Contains(referrerPolicies, r.ReferrerPolicy) {
+		return fmt.Errorf("%w: unknown referrer policy %q", ErrInvalidRedirect, r.ReferrerPolicy)
+	}
+	for name, value := range map[string]string{
+		"cache_control": r.CacheControl,
+		"robots_tag":    r.RobotsTag,
+	} {
+		if len(value) > maxHeaderValue || strings.ContainsFunc(value, func(c rune) bool { return c < ' ' || c == 0x7f }) {
+			return fmt.Errorf("%w: %s must be up to %d printable characters", ErrInvalidRedirect, name, maxHeaderValue)
+		}
+	}
+	if setting := perVisitorSetting(link); setting != "" && cachedRedirect(r) {
+		return fmt.Errorf("%w: links with %s cannot use status 301 or 308 or a cache_control that allows caching", ErrInvalidRedirect, setting)
+	}
+	return nil
+}
+
+// cachedRedirect reports whether browsers or shared caches may store the redirect:
+// permanent statuses are cached by default, other ones when cache_control allows it.
+func cachedRedirect(r models.Redirect) bool {
+	cc := strings.ToLower(r.CacheControl)
+	switch {
+	case strings.Contains(cc, "no-store"), strings.Contains(cc, "no-cache"):
+		return false
+	case strings.Contains(cc, "max-age"), strings.Contains(cc, "s-maxage"), strings.Contains(cc, "public"), strings.Contains(cc, "immutable"):
+		return true
+	default:
+		return r.Status == http.StatusMovedPermanently || r.Status == http.StatusPermanentRedirect
+	}
+}
+
+// perVisitorSetting names the first setting of link that makes its redirect
+// depend on the visitor or on the time, or that needs every click counted;
+// "" when there is none.
+func perVisitorSetting(link models.Link) string {
+	switch {
+	case link.MaxClicks > 0:
+		return "max_clicks"
+	case !link.ExpiresAt.IsZero():
+		return "expires_at"
+	case !link.ActiveFrom.IsZero():
+		return "active_from"
+	case link.Password != "":
+		return "a password"
+	case len(link.Rules) > 0:
+		return "rules"
+	case len(link.Variants) > 0:
+		return "variants"
+	case link.Passthrough.Enabled():
+		return "passthrough"
+	case link.DeepLink.Enabled():
+		return "deep_link"
+	default:
+		return ""
+	}
+}
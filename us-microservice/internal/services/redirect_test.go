@@ -0,0 +1,49 @@
+package services
+
+import (
+	"errors"
+	"testing"
+	"time"
+	"urlSh/internal/domain/models"
+)
+
+func TestValidateRedirect(t *testing.T) {
+	permanent := models.Redirect{Status: 308}
+	publicCache := models.Redirect{Status: 302, CacheControl: "public, max-age=86400"}
+
+	tests := []struct {
+		name  string
+		link  models.Link
+		valid bool
+	}{
+		{"default", models.Link{}, true},
+		{"permanent", models.Link{Redirect: permanent}, true},
+		{"cached", models.Link{Redirect: publicCache}, true},
+		{"headers", models.Link{Redirect: models.Redirect{ReferrerPolicy: "no-referrer", RobotsTag: "noindex"}}, true},
+		{"unknown status", models.Link{Redirect: models.Redirect{Status: 303}}, false},
+		{"unknown referrer policy", models.Link{Redirect: models.Redirect{ReferrerPolicy: "never"}}, false},
+		{"control character", models.Link{Redirect: models.Redirect{CacheControl: "max-age=1\r\nSet-Cookie: x"}}, false},
+
+		{"temporary with click limit", models.Link{MaxClicks: 10, Redirect: models.Redirect{Status: 307}}, true},
+		{"permanent without caching with click limit", models.Link{MaxClicks: 10, Redirect: models.Redirect{Status: 301, CacheControl: "no-store"}}, true},
+		{"permanent with click limit", models.Link{MaxClicks: 10, Redirect: permanent}, false},
+		{"private cache with expiry", models.Link{ExpiresAt: time.Now().Add(time.Hour), Redirect: models.Redirect{CacheControl: "private, max-age=60"}}, false},
+		{"cached with schedule", models.Link{ActiveFrom: time.Now().Add(time.Hour), Redirect: publicCache}, false},
+		{"cached with password", models.Link{Password: "secret", Redirect: publicCache}, false},
+		{"permanent with rules", models.Link{Rules: []models.TargetRule{{OS: []string{"ios"}}}, Redirect: permanent}, false},
+		{"permanent with variants", models.Link{Variants: []models.Variant{{Name: "A"}}, Redirect: permanent}, false},
+		{"cached with passthrough", models.Link{Passthrough: models.Passthrough{Query: true}, Redirect: models.Redirect{CacheControl: "s-maxage=60"}}, false},
+		{"permanent with deep link", models.Link{DeepLink: models.DeepLink{IOS: "app://x"}, Redirect: permanent}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateRedirect(tt.link)
+			if tt.valid && err != nil {
+				t.Errorf("validateRedirect: %v", err)
+			}
+			if !tt.valid && !errors.Is(err, ErrInvalidRedirect) {
+				t.Errorf("got %v, want %v", err, ErrInvalidRedirect)
+			}
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"github.com/yberikov/us-protos/routes"
+	"strings"
+)
+
+var ErrReservedAlias = fmt.Errorf("%w: alias is reserved", ErrInvalidLink)
+
+// maxRejectedAliases bounds the generated candidates thrown away for being
+// reserved or offensive before a request gives up.
+const maxRejectedAliases = 100
+
+// ReservedOptions configures the aliases that are never handed out.
+type ReservedOptions struct {
+	// Words are reserved on top of the gateway routes, compared case-insensitively.
+	Words []string
+	// FilterProfanity keeps offensive words out of generated aliases.
+	FilterProfanity bool
+}
+
+// profanity holds the words no generated alias may contain, lowercase.
+var profanity = []string{
+	"anal", "anus", "arse", "bitch", "boob", "butt", "cock", "cum", "cunt", "dick",
+	"dildo", "fag", "fuck", "jizz", "kike", "nazi", "nigg", "penis", "piss", "porn",
+	"pussy", "rape", "sex", "shit", "slut", "spic", "tit", "twat", "vagina", "wank", "whore",
+}
+
+// leetReplacer undoes the digit substitutions that spell words with numbers.
+var leetReplacer = strings.NewReplacer("0", "o", "1", "i", "3", "e", "4", "a", "5", "s", "7", "t", "8", "b")
+
+// newReservedSet seeds the reserved aliases with the gateway routes and adds the configured words.
+func newReservedSet(words []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(routes.Gateway)+len(words))
+	for _, w := range append(append([]string{}, routes.Gateway...), words...) {
+		set[strings.ToLower(w)] = struct{}{}
+	}
+	return set
+}
+
+func (u *URLShortener) isReserved(alias string) bool {
+	_, ok := u.reserved[strings.ToLower(alias)]
+	return ok
+}
+
+func profane(alias string) bool {
+	s := leetReplacer.Replace(strings.ToLower(alias))
+	for _, w := range profanity {
+		if strings.Contains(s, w) {
+			return true
+		}
+	}
+	return false
+}
+
+// generateAlias draws candidates until one is neither reserved nor, when the
+// filter is on, offensive.
+func (u *URLShortener) generateAlias(ctx context.Context) (string, error) {
+	for rejected := 0; ; rejected++ {
+		alias, err := u.aliases.Generate(ctx, int(u.aliasLength.Load()))
+		if err != nil {
+			return "", err
+		}
+		if !u.isReserved(alias) && !(u.opts.Reserved.FilterProfanity && profane(alias)) {
+			return alias, nil
+		}
+		if rejected >= maxRejectedAliases {
+			return "", fmt.Errorf("no acceptable alias among %d candidates", rejected+1)
+		}
+	}
+}
@@ -2,20 +2,105 @@ package services
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"golang.org/x/crypto/bcrypt"
 	"log/slog"
-	"math/rand"
+	"sync/atomic"
 	"time"
 	"urlSh/internal/domain/models"
+	"urlSh/internal/storage"
 )
 
+const (
+	minAliasLength = 3
+	maxAliasLength = 32
+)
+
+// ErrInvalidLink is wrapped by every error caused by bad input to ShortenUrl.
+var ErrInvalidLink = errors.New("invalid link")
+
+var (
+	ErrInvalidAlias  = fmt.Errorf("%w: alias must be 3-32 characters of letters, digits, '-' or '_'", ErrInvalidLink)
+	ErrInvalidExpiry = fmt.Errorf("%w: expires_at must be in the future", ErrInvalidLink)
+	ErrInvalidWindow = fmt.Errorf("%w: active_from must be before expires_at", ErrInvalidLink)
+	ErrInvalidClicks = fmt.Errorf("%w: max_clicks must not be negative", ErrInvalidLink)
+)
+
+var (
+	ErrWrongPassword   = errors.New("wrong password")
+	ErrTooManyAttempts = errors.New("too many unlock attempts")
+)
+
+// ErrAliasSpaceExhausted is returned when every generated alias candidate was already taken.
+var ErrAliasSpaceExhausted = errors.New("no free alias found")
+
+// maxUpdateAttempts bounds how often UpdateUrl starts over after a concurrent change.
+const maxUpdateAttempts = 3
+
 type UrlStorage interface {
-	SaveURL(ctx context.Context, urlToSave, alias string) (string, error)
-	GetURL(ctx context.Context, alias string) (string, error)
+	SaveURL(ctx context.Context, link models.Link) (string, error)
+	SaveURLs(ctx context.Context, links []models.Link) []error
+	GetURL(ctx context.Context, alias string) (models.Link, error)
+	ListURLs(ctx context.Context, opts models.ListOptions) ([]models.Link, string, error)
+	UpdateURL(ctx context.Context, alias string, userId int64, from, urlToSave string) (models.Link, error)
+	SetDisabled(ctx context.Context, alias string, userId int64, disabled bool) (models.Link, error)
+	DeleteURL(ctx context.Context, alias string, userId int64, purgeAt time.Time) error
+
+	SaveTemplate(ctx context.Context, t models.UTMTemplate) (models.UTMTemplate, error)
+	GetTemplate(ctx context.Context, id string, userId int64) (models.UTMTemplate, error)
+	ListTemplates(ctx context.Context, userId int64) ([]models.UTMTemplate, error)
+	UpdateTemplate(ctx context.Context, t models.UTMTemplate) (models.UTMTemplate, error)
+	DeleteTemplate(ctx context.Context, id string, userId int64) error
+	ListTemplateURLs(ctx context.Context, templateID, after string, limit int) ([]models.Link, error)
+	UpdateDestinations(ctx context.Context, old, updated []models.Link) (changed, skipped int, err error)
+	FinishPropagation(ctx context.Context, t models.UTMTemplate) error
 }
 
 type CacheStorage interface {
 	SaveURL(ctx context.Context, originalURL string, alias string, expiration time.Duration) error
+	SaveURLs(ctx context.Context, entries []models.CacheEntry) error
 	GetURL(ctx context.Context, alias string) (string, error)
+	DeleteURL(ctx context.Context, alias string) error
+	IncrClicks(ctx context.Context, alias string) (int64, error)
+	ResetClicks(ctx context.Context, alias string) error
+	CountUnlockAttempt(ctx context.Context, alias string, window time.Duration) (int64, error)
+}
+
+// Blocklist tells whether a destination must neither be shortened nor redirected to.
+type Blocklist interface {
+	Match(url string) (rule string, blocked bool)
+}
+
+// Enricher fetches the metadata of destinations in the background.
+type Enricher interface {
+	Enqueue(alias, url string)
+}
+
+// AliasOptions controls how generated aliases are retried and grown.
+type AliasOptions struct {
+	// Length is the initial length of generated aliases.
+	Length int
+	// MaxAttempts is how many candidates are tried before giving up on a request.
+	MaxAttempts int
+	// GrowThreshold is the number of collisions after which the alias length grows by one.
+	GrowThreshold int
+}
+
+// Options groups the tunables of URLShortener.
+type Options struct {
+	Alias AliasOptions
+	URL   URLOptions
+	Chain ChainOptions
+	// Reserved lists aliases that are neither generated nor accepted as custom aliases.
+	Reserved ReservedOptions
+	// DeleteQuarantine is how long the alias of a deleted or expired link stays reserved.
+	DeleteQuarantine time.Duration
+	// UnlockAttempts is how many password attempts an alias accepts per UnlockWindow.
+	UnlockAttempts int
+	UnlockWindow   time.Duration
+	// MaxBatchSize caps the number of links of one ShortenUrls call; zero means unlimited.
+	MaxBatchSize int
 }
 
 type URLShortener struct {
@@ -24,71 +109,477 @@ type URLShortener struct {
 	cache   CacheStorage
 	ttl     time.Duration
 	kafkaCh chan models.Url
+
+	aliases     AliasGenerator
+	reserved    map[string]struct{}
+	blocklist   Blocklist
+	flagged     Blocklist
+	enricher    Enricher
+	opts        Options
+	aliasLength atomic.Int64
+	collisions  atomic.Int64
 }
 
 func New(log *slog.Logger,
 	storage UrlStorage,
 	cache CacheStorage,
 	ttl time.Duration,
-	kafkaCh chan models.Url) *URLShortener {
-	return &URLShortener{
-		log:     log,
-		storage: storage,
-		cache:   cache,
-		ttl:     ttl,
-		kafkaCh: kafkaCh,
+	kafkaCh chan models.Url,
+	aliases AliasGenerator,
+	blocklist Blocklist,
+	flagged Blocklist,
+	enricher Enricher,
+	opts Options) *URLShortener {
+	u := &URLShortener{
+		log:       log,
+		storage:   storage,
+		cache:     cache,
+		ttl:       ttl,
+		kafkaCh:   kafkaCh,
+		aliases:   aliases,
+		reserved:  newReservedSet(opts.Reserved.Words),
+		blocklist: blocklist,
+		flagged:   flagged,
+		enricher:  enricher,
+		opts:      opts,
 	}
+	u.aliasLength.Store(int64(opts.Alias.Length))
+	return u
 }
 
-func (u *URLShortener) ShortenUrl(ctx context.Context, originalURL string, userId int64) (string, error) {
+// ShortenUrl stores the link and returns its alias. A non-empty link.Alias is
+// used as a custom alias, otherwise one is generated.
+func (u *URLShortener) ShortenUrl(ctx context.Context, link models.Link) (string, error) {
 	u.log.Info("attempting to shorten URL")
-	alias := generateShortURL(5)
-	url, err := u.storage.SaveURL(ctx, originalURL, alias)
+	link, err := u.prepareLink(ctx, link)
 	if err != nil {
 		return "", err
 	}
-	err = u.cache.SaveURL(ctx, originalURL, alias, u.ttl)
+
+	var alias string
+	if link.Alias == "" {
+		alias, err = u.saveWithGeneratedAlias(ctx, link)
+	} else if err = u.validateAlias(link.Alias); err == nil {
+		alias, err = u.storage.SaveURL(ctx, link)
+	}
 	if err != nil {
 		return "", err
 	}
-	urlModel := models.Url{UrlText: alias, UserId: userId}
+	if link.MaxClicks > 0 {
+		// The alias may have belonged to a purged link with its own counter.
+		if err := u.cache.ResetClicks(ctx, alias); err != nil {
+			return "", err
+		}
+	}
+	if cacheable(link, time.Now()) {
+		err = u.cache.SaveURL(ctx, link.URL, alias, u.cacheTTL(link))
+		if err != nil {
+			return "", err
+		}
+	}
+	u.enricher.Enqueue(alias, link.URL)
+	urlModel := models.Url{UrlText: alias, UserId: link.UserId}
 	u.kafkaCh <- urlModel
-	return url, nil
+	return alias, nil
+}
+
+// prepareLink validates a new link and fills in the fields derived from the request.
+func (u *URLShortener) prepareLink(ctx context.Context, link models.Link) (models.Link, error) {
+	var err error
+	if link.URL, err = normalizeURL(link.URL, u.opts.URL); err != nil {
+		return link, err
+	}
+	if link.URL, err = u.resolveChain(ctx, link.URL); err != nil {
+		return link, err
+	}
+	if err = u.checkBlocked(link.URL); err != nil {
+		return link, err
+	}
+	if link.Rules, err = u.prepareRules(ctx, link.Rules); err != nil {
+		return link, err
+	}
+	if link.Variants, err = u.prepareVariants(ctx, link.Variants); err != nil {
+		return link, err
+	}
+	if link, err = u.applyTemplate(ctx, link); err != nil {
+		return link, err
+	}
+	if link.Passthrough.Enabled() {
+		if link.Passthrough, err = validatePassthrough(link.Passthrough); err != nil {
+			return link, err
+		}
+	}
+	if err = validateRedirect(link); err != nil {
+		return link, err
+	}
+	if link.DeepLink, err = u.prepareDeepLink(link.DeepLink); err != nil {
+		return link, err
+	}
+	if link.Social, err = u.prepareSocial(link.Social); err != nil {
+		return link, err
+	}
+	link.CreatedAt = time.Now().UTC()
+	if !link.ExpiresAt.IsZero() {
+		if !link.ExpiresAt.After(link.CreatedAt) {
+			return link, ErrInvalidExpiry
+		}
+		link.ExpiresAt = link.ExpiresAt.UTC()
+		link.PurgeAt = link.ExpiresAt.Add(u.opts.DeleteQuarantine)
+	}
+	if !link.ActiveFrom.IsZero() {
+		if !link.ExpiresAt.IsZero() && !link.ActiveFrom.Before(link.ExpiresAt) {
+			return link, ErrInvalidWindow
+		}
+		link.ActiveFrom = link.ActiveFrom.UTC()
+	}
+	if link.MaxClicks < 0 {
+		return link, ErrInvalidClicks
+	}
+	if link.Password != "" {
+		hash, err := bcrypt.GenerateFromPassword([]byte(link.Password), bcrypt.DefaultCost)
+		if err != nil {
+			u.log.Error("failed to hash link password", slog.String("err", err.Error()))
+			return link, err
+		}
+		link.PasswordHash = hash
+		link.Password = ""
+	}
+	return link, nil
+}
+
+// cacheable reports whether the destination may be served straight from the cache.
+// Links that are not active yet, click-limited, password-protected, targeted,
+// split, forwarding the request, with a custom redirect, deep-linked or with
+// their own unfurl metadata must go through the storage on every lookup so
+// their rules are applied.
+func cacheable(link models.Link, now time.Time) bool {
+	return !link.NotYetActive(now) && link.MaxClicks == 0 && !link.Protected() &&
+		len(link.Rules) == 0 && len(link.Variants) == 0 && !link.Passthrough.Enabled() &&
+		link.Redirect.IsZero() && !link.DeepLink.Enabled() && link.Social.IsZero()
 }
 
-// GetOriginalURL retrieves the original URL for a given short URL.
+// GetOriginalURL retrieves the destination of a given short URL and how to
+// redirect to it. The visitor selects the destination of links with targeting
+// rules and the app URI of deep links. Destinations on the flag list are
+// marked for the interstitial preview page. Accesses of crawlers are neither
+// counted nor sent to analytics; as anyone can claim to be one, they do not
+// get the destination of click-limited links either. Protected links fail with
+// storage.ErrURLLocked and are only counted once unlocked.
 func (u *URLShortener) GetOriginalUrl(
 	ctx context.Context,
 	shortURL string,
-) (string, error) {
+	visitor models.Visitor,
+) (dest models.Destination, err error) {
 
 	u.log.Info("attempting to fetch original URL")
-	urlModel := models.Url{UrlText: shortURL, UserId: 0}
-	u.kafkaCh <- urlModel
+	defer func() {
+		if !visitor.Crawler && !errors.Is(err, storage.ErrURLLocked) {
+			u.kafkaCh <- models.Url{UrlText: shortURL, UserId: 0, Variant: dest.Variant, Source: visitor.Source}
+		}
+	}()
+
 	getURL, err := u.cache.GetURL(ctx, shortURL)
 	if err == nil && getURL != "" {
-		return getURL, nil
+		// Only links without passthrough are cached.
+		if visitor.Path != "" {
+			return models.Destination{}, storage.ErrURLNotFound
+		}
+		if err := u.checkBlocked(getURL); err != nil {
+			return models.Destination{}, err
+		}
+		return models.Destination{URL: getURL, Interstitial: u.isFlagged(getURL)}, nil
 	}
-	url, err := u.storage.GetURL(ctx, shortURL)
+	link, err := u.storage.GetURL(ctx, shortURL)
 	if err != nil {
-		return "", err
+		return models.Destination{}, err
+	}
+
+	return u.resolve(ctx, link, visitor, "")
+}
+
+// UnlockUrl returns the destination of a password-protected link once the
+// password matches, like GetOriginalUrl does for other links. Attempts are
+// rate limited per alias.
+func (u *URLShortener) UnlockUrl(ctx context.Context, shortURL, password string, visitor models.Visitor) (models.Destination, error) {
+	u.log.Info("attempting to unlock URL", slog.String("alias", shortURL))
+
+	attempts, err := u.cache.CountUnlockAttempt(ctx, shortURL, u.opts.UnlockWindow)
+	if err != nil {
+		return models.Destination{}, err
+	}
+	if attempts > int64(u.opts.UnlockAttempts) {
+		return models.Destination{}, ErrTooManyAttempts
 	}
 
-	return url, nil
+	link, err := u.storage.GetURL(ctx, shortURL)
+	if err != nil {
+		return models.Destination{}, err
+	}
+	dest, err := u.resolve(ctx, link, visitor, password)
+	if err != nil {
+		return models.Destination{}, err
+	}
+
+	u.kafkaCh <- models.Url{UrlText: shortURL, UserId: 0, Variant: dest.Variant, Source: visitor.Source}
+	return dest, nil
 }
 
-// Helper function to generate short URL
-func generateShortURL(size int) string {
-	rnd := rand.New(rand.NewSource(time.Now().UnixNano()))
+// resolve picks the destination of link for visitor and counts the click.
+// Protected links need a matching password; without one they fail with
+// storage.ErrURLLocked.
+func (u *URLShortener) resolve(ctx context.Context, link models.Link, visitor models.Visitor, password string) (models.Destination, error) {
+	if visitor.Path != "" && !link.Passthrough.Path {
+		return models.Destination{}, storage.ErrURLNotFound
+	}
+	dest, chosen := destination(link, visitor)
+	dest, err := passthrough(dest, link.Passthrough, visitor.Path, visitor.Query)
+	if err != nil {
+		return models.Destination{}, err
+	}
+	app := appURL(link.DeepLink, visitor)
+	if app != "" && link.DeepLink.Fallback != "" {
+		dest = link.DeepLink.Fallback
+	}
+	if err := u.checkBlocked(dest); err != nil {
+		return models.Destination{}, err
+	}
+	if err := available(link, time.Now()); err != nil {
+		return models.Destination{}, err
+	}
+	if link.Protected() {
+		if password == "" {
+			return models.Destination{}, storage.ErrURLLocked
+		}
+		if err := bcrypt.CompareHashAndPassword(link.PasswordHash, []byte(password)); err != nil {
+			return models.Destination{}, ErrWrongPassword
+		}
+	}
+	if visitor.Crawler {
+		if link.MaxClicks > 0 {
+			return models.Destination{Social: link.Social}, nil
+		}
+	} else if err := u.countClick(ctx, link); err != nil {
+		return models.Destination{}, err
+	}
 
-	chars := []rune("ABCDEFGHIJKLMNOPQRSTUVWXYZ" +
-		"abcdefghijklmnopqrstuvwxyz" +
-		"0123456789")
+	return models.Destination{
+		URL:          dest,
+		AppURL:       app,
+		Redirect:     link.Redirect,
+		Interstitial: u.isFlagged(dest),
+		Social:       link.Social,
+		Variant:      chosen,
+	}, nil
+}
 
-	b := make([]rune, size)
-	for i := range b {
-		b[i] = chars[rnd.Intn(len(chars))]
+// checkBlocked refuses destinations matching the blocklist. It is applied on
+// every lookup too, so links created before a rule was added stop redirecting.
+func (u *URLShortener) checkBlocked(url string) error {
+	if rule, blocked := u.blocklist.Match(url); blocked {
+		u.log.Warn("blocked destination", slog.String("url", url), slog.String("rule", rule))
+		return storage.ErrURLBlocked
 	}
+	return nil
+}
 
-	return string(b)
+// available checks that the link may redirect at the given moment.
+func available(link models.Link, now time.Time) error {
+	if link.Disabled {
+		return storage.ErrURLDisabled
+	}
+	if link.NotYetActive(now) {
+		return &storage.NotYetActiveError{ActiveFrom: link.ActiveFrom}
+	}
+	if link.Expired(now) {
+		return storage.ErrURLExpired
+	}
+	return nil
+}
+
+// countClick counts a redirect of a click-limited link and refuses it once the limit is reached.
+func (u *URLShortener) countClick(ctx context.Context, link models.Link) error {
+	if link.MaxClicks == 0 {
+		return nil
+	}
+
+	clicks, err := u.cache.IncrClicks(ctx, link.Alias)
+	if err != nil {
+		return err
+	}
+	if clicks > link.MaxClicks {
+		return storage.ErrClickLimit
+	}
+	return nil
+}
+
+// cacheTTL caps the cache expiration at the remaining lifetime of the link,
+// so the cache never outlives it.
+func (u *URLShortener) cacheTTL(link models.Link) time.Duration {
+	if link.ExpiresAt.IsZero() {
+		return u.ttl
+	}
+	return min(u.ttl, time.Until(link.ExpiresAt))
+}
+
+// ListUserUrls returns a page of links owned by opts.UserId and the cursor of the next page.
+func (u *URLShortener) ListUserUrls(ctx context.Context, opts models.ListOptions) ([]models.Link, string, error) {
+	links, next, err := u.storage.ListURLs(ctx, opts)
+	if err != nil {
+		u.log.Error("failed to list urls", slog.String("err", err.Error()))
+		return nil, "", err
+	}
+
+	return links, next, nil
+}
+
+// validateAlias checks a user supplied alias against the allowed charset and
+// length and refuses reserved aliases.
+func (u *URLShortener) validateAlias(alias string) error {
+	if u.isReserved(alias) {
+		return ErrReservedAlias
+	}
+	if len(alias) < minAliasLength || len(alias) > maxAliasLength {
+		return ErrInvalidAlias
+	}
+	for _, c := range alias {
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '-', c == '_':
+		default:
+			return ErrInvalidAlias
+		}
+	}
+	return nil
+}
+
+// UpdateUrl points an existing link owned by userId at a new destination,
+// tagged with the link's UTM template if it has one. The cached destination
+// is evicted so redirects pick up the change immediately, and the metadata of
+// the new destination is fetched again. When the destination is changed
+// concurrently, e.g. by a template being propagated, the update starts over.
+func (u *URLShortener) UpdateUrl(ctx context.Context, alias string, userId int64, originalURL string) (models.Link, error) {
+	u.log.Info("attempting to update URL", slog.String("alias", alias))
+
+	originalURL, err := normalizeURL(originalURL, u.opts.URL)
+	if err != nil {
+		return models.Link{}, err
+	}
+	if originalURL, err = u.resolveChain(ctx, originalURL); err != nil {
+		return models.Link{}, err
+	}
+	if err := u.checkBlocked(originalURL); err != nil {
+		return models.Link{}, err
+	}
+
+	var link models.Link
+	for attempt := 0; attempt < maxUpdateAttempts; attempt++ {
+		link, err = u.updateDestination(ctx, alias, userId, originalURL)
+		if !errors.Is(err, storage.ErrURLChanged) {
+			break
+		}
+	}
+	if err != nil {
+		return models.Link{}, err
+	}
+
+	if err := u.evict(ctx, alias); err != nil {
+		return models.Link{}, err
+	}
+	u.enricher.Enqueue(alias, link.URL)
+
+	return link, nil
+}
+
+// updateDestination tags originalURL with the current template of the link
+// and stores it, provided the destination has not changed since it was read.
+func (u *URLShortener) updateDestination(ctx context.Context, alias string, userId int64, originalURL string) (models.Link, error) {
+	// A missing or foreign link is reported by UpdateURL below.
+	current, err := u.storage.GetURL(ctx, alias)
+	if err != nil && !errors.Is(err, storage.ErrURLNotFound) {
+		return models.Link{}, err
+	}
+	if current.UTMTemplate != "" && current.UserId == userId {
+		t, err := u.storage.GetTemplate(ctx, current.UTMTemplate, userId)
+		if err != nil && !errors.Is(err, storage.ErrTemplateNotFound) {
+			return models.Link{}, err
+		}
+		originalURL = retagURL(originalURL, nil, t)
+	}
+
+	return u.storage.UpdateURL(ctx, alias, userId, current.URL, originalURL)
+}
+
+// DisableUrl disables, or re-enables when disabled is false, a link owned by userId.
+// A disabled link keeps its alias reserved but no longer redirects.
+func (u *URLShortener) DisableUrl(ctx context.Context, alias string, userId int64, disabled bool) (models.Link, error) {
+	u.log.Info("attempting to change URL state", slog.String("alias", alias), slog.Bool("disabled", disabled))
+
+	link, err := u.storage.SetDisabled(ctx, alias, userId, disabled)
+	if err != nil {
+		return models.Link{}, err
+	}
+
+	if err := u.evict(ctx, alias); err != nil {
+		return models.Link{}, err
+	}
+
+	return link, nil
+}
+
+// DeleteUrl deletes a link owned by userId. The alias is released only after
+// the configured quarantine so old printed links do not start pointing elsewhere right away.
+func (u *URLShortener) DeleteUrl(ctx context.Context, alias string, userId int64) error {
+	u.log.Info("attempting to delete URL", slog.String("alias", alias))
+
+	purgeAt := time.Now().UTC().Add(u.opts.DeleteQuarantine)
+	if err := u.storage.DeleteURL(ctx, alias, userId, purgeAt); err != nil {
+		return err
+	}
+
+	return u.evict(ctx, alias)
+}
+
+// evict removes the cached destination of alias so the next redirect reads the storage.
+func (u *URLShortener) evict(ctx context.Context, alias string) error {
+	if err := u.cache.DeleteURL(ctx, alias); err != nil {
+		u.log.Error("failed to evict cached url", slog.String("alias", alias), slog.String("err", err.Error()))
+		return err
+	}
+	return nil
+}
+
+// saveWithGeneratedAlias stores the URL under a generated alias, retrying with
+// a fresh candidate whenever the alias is already taken, up to MaxAttempts times.
+func (u *URLShortener) saveWithGeneratedAlias(ctx context.Context, link models.Link) (string, error) {
+	for attempt := 0; attempt < u.opts.Alias.MaxAttempts; attempt++ {
+		var err error
+		link.Alias, err = u.generateAlias(ctx)
+		if err != nil {
+			return "", err
+		}
+
+		saved, err := u.storage.SaveURL(ctx, link)
+		if err == nil {
+			return saved, nil
+		}
+		if !errors.Is(err, storage.ErrURLExists) {
+			return "", err
+		}
+		u.recordCollision()
+	}
+
+	return "", ErrAliasSpaceExhausted
+}
+
+// recordCollision grows the generated alias length by one once collisions
+// pass the configured threshold, so the keyspace keeps up with the number of links.
+func (u *URLShortener) recordCollision() {
+	if u.collisions.Add(1) < int64(u.opts.Alias.GrowThreshold) {
+		return
+	}
+
+	length := u.aliasLength.Load()
+	if length < maxAliasLength && u.aliasLength.CompareAndSwap(length, length+1) {
+		u.collisions.Store(0)
+		u.log.Warn("alias collisions passed threshold, growing alias length", slog.Int64("length", length+1))
+	}
 }
@@ -0,0 +1,52 @@
+package services
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+	"urlSh/internal/domain/models"
+)
+
+var ErrInvalidSocial = fmt.Errorf("%w: invalid social card", ErrInvalidLink)
+
+// Bounds of the social card fields, in characters. Unfurlers cut titles and
+// descriptions well before them.
+const (
+	maxSocialTitle       = 200
+	maxSocialDescription = 500
+	maxSocialImage       = 2048
+)
+
+// prepareSocial validates the unfurl metadata of a link. The image must be an
+// absolute http(s) URL that is not blocked.
+func (u *URLShortener) prepareSocial(s models.SocialCard) (models.SocialCard, error) {
+	s.Title = strings.TrimSpace(s.Title)
+	s.Description = strings.TrimSpace(s.Description)
+	s.Image = strings.TrimSpace(s.Image)
+
+	if utf8.RuneCountInString(s.Title) > maxSocialTitle || strings.ContainsFunc(s.Title, unicode.IsControl) {
+		return s, fmt.Errorf("%w: title must be up to %d printable characters", ErrInvalidSocial, maxSocialTitle)
+	}
+	// Descriptions may span lines.
+	if utf8.RuneCountInString(s.Description) > maxSocialDescription ||
+		strings.ContainsFunc(s.Description, func(c rune) bool { return unicode.IsControl(c) && c != '\n' }) {
+		return s, fmt.Errorf("%w: description must be up to %d printable characters", ErrInvalidSocial, maxSocialDescription)
+	}
+
+	if s.Image != "" {
+		if len(s.Image) > maxSocialImage {
+			return s, fmt.Errorf("%w: image must be at most %d characters", ErrInvalidSocial, maxSocialImage)
+		}
+		parsed, err := url.Parse(s.Image)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return s, fmt.Errorf("%w: image must be an absolute http or https URL", ErrInvalidSocial)
+		}
+		if err := u.checkBlocked(s.Image); err != nil {
+			return s, err
+		}
+	}
+
+	return s, nil
+}
@@ -0,0 +1,102 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"urlSh/internal/domain/models"
+)
+
+var ErrInvalidVariant = fmt.Errorf("%w: invalid variant", ErrInvalidLink)
+
+const (
+	maxVariants    = 10
+	maxTotalWeight = 1_000_000
+)
+
+// prepareVariants validates the destinations of a split link and names the
+// unnamed ones "A", "B", ... by position.
+func (u *URLShortener) prepareVariants(ctx context.Context, variants []models.Variant) ([]models.Variant, error) {
+	if len(variants) == 0 {
+		return nil, nil
+	}
+	if len(variants) < 2 || len(variants) > maxVariants {
+		return nil, fmt.Errorf("%w: a split link needs 2-%d variants", ErrInvalidVariant, maxVariants)
+	}
+
+	total := 0
+	names := make(map[string]bool, len(variants))
+	prepared := make([]models.Variant, 0, len(variants))
+	for i, v := range variants {
+		if v.Name == "" {
+			v.Name = string(rune('A' + i))
+		}
+		if !validName(v.Name) || names[v.Name] {
+			return nil, fmt.Errorf("%w: variant %d needs a unique name of up to 32 letters, digits, '-' or '_'", ErrInvalidVariant, i+1)
+		}
+		names[v.Name] = true
+
+		if v.Weight <= 0 {
+			return nil, fmt.Errorf("%w: variant %q needs a positive weight", ErrInvalidVariant, v.Name)
+		}
+		total += v.Weight
+		if total > maxTotalWeight {
+			return nil, fmt.Errorf("%w: weights must add up to at most %d", ErrInvalidVariant, maxTotalWeight)
+		}
+
+		var err error
+		if v.URL, err = normalizeURL(v.URL, u.opts.URL); err != nil {
+			return nil, err
+		}
+		if v.URL, err = u.resolveChain(ctx, v.URL); err != nil {
+			return nil, err
+		}
+		if err = u.checkBlocked(v.URL); err != nil {
+			return nil, err
+		}
+
+		prepared = append(prepared, v)
+	}
+
+	return prepared, nil
+}
+
+// pickVariant chooses a variant with a probability proportional to its weight.
+// The choice only depends on the alias and the visitor id, so a returning
+// visitor lands on the same variant; visitors without an id get a random one.
+func pickVariant(alias, visitorID string, variants []models.Variant) models.Variant {
+	total := 0
+	for _, v := range variants {
+		total += v.Weight
+	}
+
+	var n int
+	if visitorID == "" {
+		n = rand.Intn(total)
+	} else {
+		h := fnv.New64a()
+		h.Write([]byte(alias + ":" + visitorID))
+		n = int(h.Sum64() % uint64(total))
+	}
+
+	for _, v := range variants {
+		if n < v.Weight {
+			return v
+		}
+		n -= v.Weight
+	}
+	return variants[len(variants)-1]
+}
+
+func validName(name string) bool {
+	if name == "" || len(name) > 32 {
+		return false
+	}
+	for _, c := range name {
+		if !isAlnum(c) && c != '-' && c != '_' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,94 @@
+package services
+
+import (
+	"math"
+	"strconv"
+	"testing"
+	"urlSh/internal/domain/models"
+)
+
+func TestPickVariantSticky(t *testing.T) {
+	variants := []models.Variant{
+		{Name: "A", URL: "https://example.com/a", Weight: 50},
+		{Name: "B", URL: "https://example.com/b", Weight: 50},
+	}
+
+	for i := 0; i < 100; i++ {
+		id := "visitor-" + strconv.Itoa(i)
+		first := pickVariant("abc", id, variants)
+		for j := 0; j < 5; j++ {
+			if got := pickVariant("abc", id, variants); got != first {
+				t.Fatalf("visitor %s got %s, then %s", id, first.Name, got.Name)
+			}
+		}
+	}
+}
+
+func TestPickVariantWeights(t *testing.T) {
+	variants := []models.Variant{
+		{Name: "A", Weight: 70},
+		{Name: "B", Weight: 20},
+		{Name: "C", Weight: 10},
+	}
+
+	for _, sticky := range []bool{true, false} {
+		const n = 20000
+		counts := make(map[string]int)
+		for i := 0; i < n; i++ {
+			id := ""
+			if sticky {
+				id = strconv.Itoa(i)
+			}
+			counts[pickVariant("abc", id, variants).Name]++
+		}
+
+		for _, v := range variants {
+			share := float64(counts[v.Name]) / n * 100
+			if math.Abs(share-float64(v.Weight)) > 2 {
+				t.Errorf("sticky=%v: variant %s got %.1f%% of visitors, want about %d%%", sticky, v.Name, share, v.Weight)
+			}
+		}
+	}
+}
+
+func TestDestinationVariants(t *testing.T) {
+	link := models.Link{
+		Alias: "abc",
+		URL:   "https://example.com/",
+		Rules: []models.TargetRule{{OS: []string{"ios"}, URL: "https://example.com/ios"}},
+		Variants: []models.Variant{
+			{Name: "A", URL: "https://example.com/a", Weight: 1},
+			{Name: "B", URL: "https://example.com/b", Weight: 1},
+		},
+	}
+
+	// Rules win over the split.
+	if url, variant := destination(link, models.Visitor{ID: "x", UserAgent: "iPhone"}); url != "https://example.com/ios" || variant != "" {
+		t.Errorf("destination() = %q, %q; want the ios rule without a variant", url, variant)
+	}
+
+	url, variant := destination(link, models.Visitor{ID: "x"})
+	want := pickVariant("abc", "x", link.Variants)
+	if url != want.URL || variant != want.Name {
+		t.Errorf("destination() = %q, %q; want %q, %q", url, variant, want.URL, want.Name)
+	}
+}
+
+func TestValidName(t *testing.T) {
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{"A", true},
+		{"control_2024-v2", true},
+		{"", false},
+		{"has space", false},
+		{"naïve", false},
+		{"x23456789012345678901234567890123", false},
+	}
+	for _, tt := range tests {
+		if got := validName(tt.name); got != tt.want {
+			t.Errorf("validName(%q) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
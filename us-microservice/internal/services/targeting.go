@@ -0,0 +1,203 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"urlSh/internal/domain/models"
+)
+
+var ErrInvalidRule = fmt.Errorf("%w: invalid targeting rule", ErrInvalidLink)
+
+// maxRules bounds the rules of one link, they are evaluated on every redirect.
+const maxRules = 20
+
+var knownOS = []string{"ios", "android", "windows", "macos", "linux", "chromeos"}
+
+// prepareRules validates targeting rules and normalizes their conditions and
+// destinations the same way as the main destination.
+func (u *URLShortener) prepareRules(ctx context.Context, rules []models.TargetRule) ([]models.TargetRule, error) {
+	if len(rules) > maxRules {
+		return nil, fmt.Errorf("%w: at most %d rules are allowed", ErrInvalidRule, maxRules)
+	}
+
+	prepared := make([]models.TargetRule, 0, len(rules))
+	for i, r := range rules {
+		if len(r.OS) == 0 && len(r.Languages) == 0 && len(r.Countries) == 0 && r.UserAgent == "" {
+			return nil, fmt.Errorf("%w: rule %d has no condition", ErrInvalidRule, i+1)
+		}
+
+		for j, os := range r.OS {
+			r.OS[j] = strings.ToLower(os)
+			if !slices.Contains(knownOS, r.OS[j]) {
+				return nil, fmt.Errorf("%w: rule %d: unknown os %q", ErrInvalidRule, i+1, os)
+			}
+		}
+		for j, lang := range r.Languages {
+			if lang == "" || strings.ContainsFunc(lang, func(c rune) bool { return c != '-' && !isAlnum(c) }) {
+				return nil, fmt.Errorf("%w: rule %d: invalid language %q", ErrInvalidRule, i+1, lang)
+			}
+			r.Languages[j] = strings.ToLower(lang)
+		}
+		for j, country := range r.Countries {
+			if len(country) != 2 {
+				return nil, fmt.Errorf("%w: rule %d: invalid country %q", ErrInvalidRule, i+1, country)
+			}
+			r.Countries[j] = strings.ToUpper(country)
+		}
+		if r.UserAgent != "" {
+			if _, err := regexp.Compile(r.UserAgent); err != nil {
+				return nil, fmt.Errorf("%w: rule %d: invalid user_agent pattern", ErrInvalidRule, i+1)
+			}
+		}
+
+		var err error
+		if r.URL, err = normalizeURL(r.URL, u.opts.URL); err != nil {
+			return nil, err
+		}
+		if r.URL, err = u.resolveChain(ctx, r.URL); err != nil {
+			return nil, err
+		}
+		if err = u.checkBlocked(r.URL); err != nil {
+			return nil, err
+		}
+
+		prepared = append(prepared, r)
+	}
+
+	return prepared, nil
+}
+
+// destination picks the URL a visitor is redirected to: the one of the first
+// matching rule, else one of the variants of a split link, else the link URL.
+// variant names the chosen variant, if any.
+func destination(link models.Link, visitor models.Visitor) (url, variant string) {
+	if len(link.Rules) == 0 && len(link.Variants) == 0 {
+		return link.URL, ""
+	}
+
+	os := detectOS(visitor.UserAgent)
+	country := strings.ToUpper(visitor.Country)
+
+	// The rules are tried once per offered language, most preferred first, so
+	// a visitor accepting "fr, de" gets the German page when no French rule
+	// matches, regardless of the rule order. The final pass with no language
+	// only matches rules without a language condition.
+	langs := append(offeredLanguages(link.Rules, parseAcceptLanguage(visitor.AcceptLanguage)), "")
+	for _, lang := range langs {
+		for _, r := range link.Rules {
+			if matchRule(r, os, lang, country, visitor.UserAgent) {
+				return r.URL, ""
+			}
+		}
+	}
+
+	if len(link.Variants) > 0 {
+		v := pickVariant(link.Alias, visitor.ID, link.Variants)
+		return v.URL, v.Name
+	}
+
+	return link.URL, ""
+}
+
+// detectOS maps a User-Agent header to one of knownOS, or "" when unknown.
+func detectOS(ua string) string {
+	switch {
+	case strings.Contains(ua, "iPhone"), strings.Contains(ua, "iPad"), strings.Contains(ua, "iPod"):
+		return "ios"
+	case strings.Contains(ua, "Android"):
+		return "android"
+	case strings.Contains(ua, "CrOS"):
+		return "chromeos"
+	case strings.Contains(ua, "Windows"):
+		return "windows"
+	case strings.Contains(ua, "Macintosh"), strings.Contains(ua, "Mac OS X"):
+		return "macos"
+	case strings.Contains(ua, "Linux"), strings.Contains(ua, "X11"):
+		return "linux"
+	default:
+		return ""
+	}
+}
+
+// parseAcceptLanguage returns the accepted language tags, most preferred first.
+func parseAcceptLanguage(header string) []string {
+	type weighted struct {
+		tag string
+		q   float64
+	}
+
+	var tags []weighted
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || tag == "*" {
+			continue
+		}
+		q := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if f, err := strconv.ParseFloat(v, 64); err == nil {
+				q = f
+			}
+		}
+		if q > 0 {
+			tags = append(tags, weighted{tag, q})
+		}
+	}
+	sort.SliceStable(tags, func(i, j int) bool { return tags[i].q > tags[j].q })
+
+	langs := make([]string, 0, len(tags))
+	for _, t := range tags {
+		langs = append(langs, t.tag)
+	}
+	return langs
+}
+
+// matchRule reports whether every condition of r holds for a visitor on os
+// from country, negotiated to lang.
+func matchRule(r models.TargetRule, os, lang, country, userAgent string) bool {
+	if len(r.OS) > 0 && !slices.Contains(r.OS, os) {
+		return false
+	}
+	if len(r.Languages) > 0 && !slices.ContainsFunc(r.Languages, func(l string) bool { return matchLanguage(l, lang) }) {
+		return false
+	}
+	if len(r.Countries) > 0 && !slices.Contains(r.Countries, country) {
+		return false
+	}
+	if r.UserAgent != "" {
+		re, err := regexp.Compile("(?i)" + r.UserAgent)
+		if err != nil || !re.MatchString(userAgent) {
+			return false
+		}
+	}
+	return true
+}
+
+// offeredLanguages returns the visitor's languages that some rule offers,
+// most preferred first.
+func offeredLanguages(rules []models.TargetRule, accepted []string) []string {
+	var offered []string
+	for _, lang := range accepted {
+		for _, r := range rules {
+			if slices.ContainsFunc(r.Languages, func(l string) bool { return matchLanguage(l, lang) }) {
+				offered = append(offered, lang)
+				break
+			}
+		}
+	}
+	return offered
+}
+
+// matchLanguage reports whether the rule tag covers the visitor tag: "de" covers "de" and "de-at".
+func matchLanguage(ruleTag, tag string) bool {
+	return tag != "" && (tag == ruleTag || strings.HasPrefix(tag, ruleTag+"-"))
+}
+
+func isAlnum(c rune) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
@@ -0,0 +1,88 @@
+package services
+
+import (
+	"slices"
+	"testing"
+	"urlSh/internal/domain/models"
+)
+
+func TestParseAcceptLanguage(t *testing.T) {
+	tests := []struct {
+		header string
+		want   []string
+	}{
+		{"", []string{}},
+		{"de", []string{"de"}},
+		{"de-AT, de;q=0.9, en;q=0.8", []string{"de-at", "de", "en"}},
+		{"en;q=0.5, fr", []string{"fr", "en"}},
+		{"fr;q=0.8, de;q=0.8, it;q=0.9", []string{"it", "fr", "de"}},
+		{"*, es", []string{"es"}},
+		{"en;q=0, pt", []string{"pt"}},
+		{"en;q=bogus, pt;q=0.5", []string{"en", "pt"}},
+		{" , ja ,", []string{"ja"}},
+	}
+	for _, tt := range tests {
+		if got := parseAcceptLanguage(tt.header); !slices.Equal(got, tt.want) {
+			t.Errorf("parseAcceptLanguage(%q) = %q, want %q", tt.header, got, tt.want)
+		}
+	}
+}
+
+func TestDetectOS(t *testing.T) {
+	tests := []struct {
+		ua   string
+		want string
+	}{
+		{"Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X)", "ios"},
+		{"Mozilla/5.0 (iPad; CPU OS 16_0 like Mac OS X)", "ios"},
+		{"Mozilla/5.0 (Linux; Android 14; Pixel 8)", "android"},
+		{"Mozilla/5.0 (X11; CrOS x86_64 14541.0.0)", "chromeos"},
+		{"Mozilla/5.0 (Windows NT 10.0; Win64; x64)", "windows"},
+		{"Mozilla/5.0 (Macintosh; Intel Mac OS X 14_0)", "macos"},
+		{"Mozilla/5.0 (X11; Linux x86_64)", "linux"},
+		{"curl/8.0", ""},
+	}
+	for _, tt := range tests {
+		if got := detectOS(tt.ua); got != tt.want {
+			t.Errorf("detectOS(%q) = %q, want %q", tt.ua, got, tt.want)
+		}
+	}
+}
+
+func TestDestinationRules(t *testing.T) {
+	link := models.Link{
+		Alias: "abc",
+		URL:   "https://example.com/",
+		Rules: []models.TargetRule{
+			{OS: []string{"ios"}, URL: "https://example.com/ios"},
+			{Languages: []string{"fr"}, Countries: []string{"FR", "BE"}, URL: "https://example.com/fr"},
+			{Languages: []string{"de"}, Countries: []string{"AT"}, URL: "https://example.com/de-at"},
+			{Languages: []string{"de"}, URL: "https://example.com/de"},
+			{UserAgent: "bot|crawler", URL: "https://example.com/bots"},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		visitor models.Visitor
+		want    string
+	}{
+		{"no match", models.Visitor{UserAgent: "Mozilla/5.0 (Windows NT 10.0)"}, "https://example.com/"},
+		{"os", models.Visitor{UserAgent: "Mozilla/5.0 (iPhone)", AcceptLanguage: "fr"}, "https://example.com/ios"},
+		{"language", models.Visitor{AcceptLanguage: "fr-BE", Country: "BE"}, "https://example.com/fr"},
+		{"next language when the preferred rule fails", models.Visitor{AcceptLanguage: "fr, de", Country: "CA"}, "https://example.com/de"},
+		{"rule without language after the languages fail", models.Visitor{AcceptLanguage: "fr", Country: "CA", UserAgent: "crawler"}, "https://example.com/bots"},
+		{"language and country", models.Visitor{AcceptLanguage: "de", Country: "at"}, "https://example.com/de-at"},
+		{"language in other country", models.Visitor{AcceptLanguage: "de", Country: "CH"}, "https://example.com/de"},
+		{"preferred offered language", models.Visitor{AcceptLanguage: "es, de;q=0.9, fr;q=0.8"}, "https://example.com/de"},
+		{"user agent pattern", models.Visitor{UserAgent: "Some-Crawler/1.0"}, "https://example.com/bots"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, variant := destination(link, tt.visitor)
+			if got != tt.want || variant != "" {
+				t.Errorf("destination() = %q, %q; want %q", got, variant, tt.want)
+			}
+		})
+	}
+}
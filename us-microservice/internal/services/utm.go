@@ -0,0 +1,244 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+	"urlSh/internal/domain/models"
+	"urlSh/internal/storage"
+)
+
+// ErrInvalidTemplate is wrapped by every error caused by bad input to a template call.
+var ErrInvalidTemplate = errors.New("invalid utm template")
+
+// maxTemplateValue bounds the name and every parameter of a template.
+const maxTemplateValue = 100
+
+// propagateBatch is the number of links re-tagged per storage round trip.
+const propagateBatch = 200
+
+// CreateTemplate stores a new UTM template owned by t.UserId.
+func (u *URLShortener) CreateTemplate(ctx context.Context, t models.UTMTemplate) (models.UTMTemplate, error) {
+	u.log.Info("attempting to create utm template", slog.Int64("user_id", t.UserId))
+
+	t, err := validateTemplate(t)
+	if err != nil {
+		return models.UTMTemplate{}, err
+	}
+	t.CreatedAt = time.Now().UTC()
+	t.UpdatedAt = t.CreatedAt
+
+	return u.storage.SaveTemplate(ctx, t)
+}
+
+// GetTemplate returns a template owned by userId.
+func (u *URLShortener) GetTemplate(ctx context.Context, id string, userId int64) (models.UTMTemplate, error) {
+	return u.storage.GetTemplate(ctx, id, userId)
+}
+
+// ListTemplates returns the templates owned by userId.
+func (u *URLShortener) ListTemplates(ctx context.Context, userId int64) ([]models.UTMTemplate, error) {
+	return u.storage.ListTemplates(ctx, userId)
+}
+
+// UpdateTemplate changes a template owned by t.UserId. With propagate the
+// destinations of the links created with it are re-tagged in batches and
+// evicted from the cache; the number of re-tagged links is returned. Links
+// edited while they are re-tagged keep the edit. The parameters still to be
+// replaced are kept on the template until every link is done, so repeating
+// the update after a failure picks up the links that were left.
+func (u *URLShortener) UpdateTemplate(ctx context.Context, t models.UTMTemplate, propagate bool) (models.UTMTemplate, int, error) {
+	u.log.Info("attempting to update utm template", slog.String("id", t.ID), slog.Bool("propagate", propagate))
+
+	t, err := validateTemplate(t)
+	if err != nil {
+		return models.UTMTemplate{}, 0, err
+	}
+	old, err := u.storage.GetTemplate(ctx, t.ID, t.UserId)
+	if err != nil {
+		return models.UTMTemplate{}, 0, err
+	}
+	t.UpdatedAt = time.Now().UTC()
+	t.Pending = old.Pending
+	if propagate {
+		t.Pending = staleParams(old)
+	}
+
+	updated, err := u.storage.UpdateTemplate(ctx, t)
+	if err != nil {
+		return models.UTMTemplate{}, 0, err
+	}
+	if !propagate {
+		return updated, 0, nil
+	}
+
+	n, err := u.propagateTemplate(ctx, updated)
+	if err != nil {
+		return models.UTMTemplate{}, 0, err
+	}
+	if err := u.storage.FinishPropagation(ctx, updated); err != nil {
+		return models.UTMTemplate{}, 0, err
+	}
+	updated.Pending = nil
+
+	return updated, n, nil
+}
+
+// propagateTemplate re-tags the links created with t, replacing its pending
+// parameters with its current ones, and returns the number of links changed.
+// A batch in which links were edited concurrently is read and re-tagged again.
+func (u *URLShortener) propagateTemplate(ctx context.Context, t models.UTMTemplate) (int, error) {
+	n := 0
+	after := ""
+	for {
+		var links []models.Link
+		var skipped int
+		for attempt := 0; attempt < maxUpdateAttempts; attempt++ {
+			var changed int
+			var err error
+			if links, changed, skipped, err = u.retagBatch(ctx, t, after); err != nil {
+				return n, err
+			}
+			n += changed
+			if skipped == 0 {
+				break
+			}
+		}
+		if skipped > 0 {
+			u.log.Warn("links changed while propagating utm template", slog.String("id", t.ID), slog.Int("skipped", skipped))
+		}
+
+		if len(links) < propagateBatch {
+			return n, nil
+		}
+		after = links[len(links)-1].Alias
+	}
+}
+
+// retagBatch re-tags the next batch of links created with t whose alias sorts
+// after the given one and evicts their cached destinations.
+func (u *URLShortener) retagBatch(ctx context.Context, t models.UTMTemplate, after string) (links []models.Link, changed, skipped int, err error) {
+	links, err = u.storage.ListTemplateURLs(ctx, t.ID, after, propagateBatch)
+	if err != nil || len(links) == 0 {
+		return links, 0, 0, err
+	}
+
+	retagged := make([]models.Link, 0, len(links))
+	for _, link := range links {
+		retagged = append(retagged, retagLink(link, t.Pending, t))
+	}
+	if changed, skipped, err = u.storage.UpdateDestinations(ctx, links, retagged); err != nil {
+		return nil, 0, 0, err
+	}
+	for _, link := range links {
+		// Errors are logged; the cached destination expires on its own.
+		_ = u.evict(ctx, link.Alias)
+	}
+
+	return links, changed, skipped, nil
+}
+
+// staleParams returns the names of the parameters links created with old may
+// carry: those of old and those still pending from earlier updates.
+func staleParams(old models.UTMTemplate) []string {
+	names := slices.Clone(old.Pending)
+	for _, p := range old.Params() {
+		if !slices.Contains(names, p[0]) {
+			names = append(names, p[0])
+		}
+	}
+	return names
+}
+
+// DeleteTemplate deletes a template owned by userId. Links created with it keep their parameters.
+func (u *URLShortener) DeleteTemplate(ctx context.Context, id string, userId int64) error {
+	u.log.Info("attempting to delete utm template", slog.String("id", id))
+
+	return u.storage.DeleteTemplate(ctx, id, userId)
+}
+
+// applyTemplate tags every destination of a new link with the parameters of its template.
+func (u *URLShortener) applyTemplate(ctx context.Context, link models.Link) (models.Link, error) {
+	if link.UTMTemplate == "" {
+		return link, nil
+	}
+
+	t, err := u.storage.GetTemplate(ctx, link.UTMTemplate, link.UserId)
+	if errors.Is(err, storage.ErrTemplateNotFound) {
+		return link, fmt.Errorf("%w: unknown utm_template %q", ErrInvalidLink, link.UTMTemplate)
+	}
+	if err != nil {
+		return link, err
+	}
+
+	return retagLink(link, nil, t), nil
+}
+
+// retagLink replaces the stale parameters with those of t in every destination of the link.
+func retagLink(link models.Link, stale []string, t models.UTMTemplate) models.Link {
+	link.URL = retagURL(link.URL, stale, t)
+	link.Rules = slices.Clone(link.Rules)
+	for i := range link.Rules {
+		link.Rules[i].URL = retagURL(link.Rules[i].URL, stale, t)
+	}
+	link.Variants = slices.Clone(link.Variants)
+	for i := range link.Variants {
+		link.Variants[i].URL = retagURL(link.Variants[i].URL, stale, t)
+	}
+	return link
+}
+
+// retagURL drops the stale parameters from dest and merges in those of t.
+// Parameters of t win over ones already on the destination; the other
+// parameters keep their order and encoding.
+func retagURL(dest string, stale []string, t models.UTMTemplate) string {
+	target, err := url.Parse(dest)
+	if err != nil {
+		return dest
+	}
+
+	drop := make(map[string]bool)
+	for _, name := range stale {
+		drop[name] = true
+	}
+	for _, p := range t.Params() {
+		drop[p[0]] = true
+	}
+
+	var pairs []string
+	for _, pair := range splitQuery(target.RawQuery) {
+		if !drop[queryName(pair)] {
+			pairs = append(pairs, pair)
+		}
+	}
+	for _, p := range t.Params() {
+		pairs = append(pairs, p[0]+"="+url.QueryEscape(p[1]))
+	}
+
+	target.RawQuery = strings.Join(pairs, "&")
+	return target.String()
+}
+
+func validateTemplate(t models.UTMTemplate) (models.UTMTemplate, error) {
+	t.Name = strings.TrimSpace(t.Name)
+	if t.Name == "" {
+		return t, fmt.Errorf("%w: name is required", ErrInvalidTemplate)
+	}
+
+	for _, f := range []*string{&t.Name, &t.Source, &t.Medium, &t.Campaign, &t.Term, &t.Content} {
+		*f = strings.TrimSpace(*f)
+		if len(*f) > maxTemplateValue || strings.ContainsFunc(*f, func(c rune) bool { return c < ' ' || c == 0x7f }) {
+			return t, fmt.Errorf("%w: values must be up to %d printable characters", ErrInvalidTemplate, maxTemplateValue)
+		}
+	}
+	if len(t.Params()) == 0 {
+		return t, fmt.Errorf("%w: at least one parameter is required", ErrInvalidTemplate)
+	}
+
+	return t, nil
+}
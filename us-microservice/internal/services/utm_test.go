@@ -0,0 +1,108 @@
+package services
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"urlSh/internal/domain/models"
+)
+
+func TestRetagURL(t *testing.T) {
+	tmpl := models.UTMTemplate{Source: "newsletter", Medium: "email", Campaign: "spring sale"}
+
+	tests := []struct {
+		name  string
+		dest  string
+		stale []string
+		want  string
+	}{
+		{"no query", "https://example.com/", nil, "https://example.com/?utm_source=newsletter&utm_medium=email&utm_campaign=spring+sale"},
+		{"other parameters kept", "https://example.com/?b=%20&a=1", nil, "https://example.com/?b=%20&a=1&utm_source=newsletter&utm_medium=email&utm_campaign=spring+sale"},
+		{"template wins", "https://example.com/?utm_source=x&a=1&utm_medium=y", nil, "https://example.com/?a=1&utm_source=newsletter&utm_medium=email&utm_campaign=spring+sale"},
+		{"encoded names replaced", "https://example.com/?utm%5Fsource=x", nil, "https://example.com/?utm_source=newsletter&utm_medium=email&utm_campaign=spring+sale"},
+		{"stale dropped", "https://example.com/?utm_term=old&utm_content=old&a=1", []string{"utm_term"}, "https://example.com/?utm_content=old&a=1&utm_source=newsletter&utm_medium=email&utm_campaign=spring+sale"},
+		{"fragment kept", "https://example.com/p#top", nil, "https://example.com/p?utm_source=newsletter&utm_medium=email&utm_campaign=spring+sale#top"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := retagURL(tt.dest, tt.stale, tmpl); got != tt.want {
+				t.Errorf("retagURL(%q) = %q, want %q", tt.dest, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetagURLIdempotent(t *testing.T) {
+	old := models.UTMTemplate{Source: "a", Term: "t"}
+	updated := models.UTMTemplate{Source: "b", Medium: "m"}
+
+	dest := retagURL("https://example.com/?x=1", nil, old)
+	once := retagURL(dest, staleParams(old), updated)
+	twice := retagURL(once, staleParams(old), updated)
+
+	if want := "https://example.com/?x=1&utm_source=b&utm_medium=m"; once != want {
+		t.Errorf("retagURL() = %q, want %q", once, want)
+	}
+	if twice != once {
+		t.Errorf("retagURL() is not idempotent: %q, then %q", once, twice)
+	}
+}
+
+func TestRetagLink(t *testing.T) {
+	link := models.Link{
+		URL:      "https://example.com/",
+		Rules:    []models.TargetRule{{OS: []string{"ios"}, URL: "https://example.com/ios"}},
+		Variants: []models.Variant{{Name: "A", URL: "https://example.com/a", Weight: 1}},
+	}
+
+	got := retagLink(link, nil, models.UTMTemplate{Source: "x"})
+
+	for _, tt := range []struct{ got, want string }{
+		{got.URL, "https://example.com/?utm_source=x"},
+		{got.Rules[0].URL, "https://example.com/ios?utm_source=x"},
+		{got.Variants[0].URL, "https://example.com/a?utm_source=x"},
+	} {
+		if tt.got != tt.want {
+			t.Errorf("got %q, want %q", tt.got, tt.want)
+		}
+	}
+	if link.Rules[0].URL != "https://example.com/ios" || link.Variants[0].URL != "https://example.com/a" {
+		t.Error("retagLink modified the original link")
+	}
+}
+
+func TestStaleParams(t *testing.T) {
+	tests := []struct {
+		old  models.UTMTemplate
+		want []string
+	}{
+		{models.UTMTemplate{}, nil},
+		{models.UTMTemplate{Source: "a", Content: "c"}, []string{"utm_source", "utm_content"}},
+		{models.UTMTemplate{Source: "a", Pending: []string{"utm_term", "utm_source"}}, []string{"utm_term", "utm_source"}},
+	}
+	for _, tt := range tests {
+		if got := staleParams(tt.old); !slices.Equal(got, tt.want) {
+			t.Errorf("staleParams(%+v) = %q, want %q", tt.old, got, tt.want)
+		}
+	}
+}
+
+func TestValidateTemplate(t *testing.T) {
+	got, err := validateTemplate(models.UTMTemplate{Name: " spring ", Source: " newsletter "})
+	if err != nil {
+		t.Fatalf("validateTemplate: %v", err)
+	}
+	if got.Name != "spring" || got.Source != "newsletter" {
+		t.Errorf("validateTemplate() = %+v, want trimmed values", got)
+	}
+
+	for _, tmpl := range []models.UTMTemplate{
+		{Source: "a"},
+		{Name: "no params"},
+		{Name: "control", Source: "a\nb"},
+	} {
+		if _, err := validateTemplate(tmpl); !errors.Is(err, ErrInvalidTemplate) {
+			t.Errorf("validateTemplate(%+v) = %v, want %v", tmpl, err, ErrInvalidTemplate)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"urlSh/internal/domain/models"
+	"urlSh/internal/storage"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// HealthDocument is the last health check of the destination, see models.Health.
+type HealthDocument struct {
+	Status        int           `bson:"status,omitempty"`
+	Error         string        `bson:"error,omitempty"`
+	Latency       time.Duration `bson:"latency"`
+	CheckedAt     time.Time     `bson:"checked_at"`
+	LastHealthyAt *time.Time    `bson:"last_healthy_at,omitempty"`
+	Failures      int           `bson:"failures"`
+	Broken        bool          `bson:"broken"`
+}
+
+// ClaimCheckTargets claims up to limit active links whose destination was
+// last checked before checkedBefore, or never, least recently checked first.
+// Each link is leased until leaseUntil with an atomic update, so replicas
+// checking at the same time never get the same link.
+func (s *Storage) ClaimCheckTargets(ctx context.Context, checkedBefore, leaseUntil time.Time, limit int) ([]models.Link, error) {
+	const op = "storage.mongodb.ClaimCheckTargets"
+
+	now := time.Now().UTC()
+	filter := bson.D{
+		notDeleted,
+		{Key: "disabled_at", Value: bson.D{{Key: "$exists", Value: false}}},
+		{Key: "health_lease", Value: bson.D{{Key: "$not", Value: bson.D{{Key: "$gt", Value: now}}}}},
+		{Key: "$and", Value: bson.A{
+			bson.D{{Key: "$or", Value: bson.A{
+				bson.D{{Key: "health.checked_at", Value: bson.D{{Key: "$lt", Value: checkedBefore}}}},
+				bson.D{{Key: "health.checked_at", Value: bson.D{{Key: "$exists", Value: false}}}},
+			}}},
+			bson.D{{Key: "$or", Value: bson.A{
+				bson.D{{Key: "expires_at", Value: bson.D{{Key: "$gt", Value: now}}}},
+				bson.D{{Key: "expires_at", Value: bson.D{{Key: "$exists", Value: false}}}},
+			}}},
+		}},
+	}
+	update := bson.D{{Key: "$set", Value: bson.D{{Key: "health_lease", Value: leaseUntil.UTC()}}}}
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "health.checked_at", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	var links []models.Link
+	for len(links) < limit {
+		var doc URLDocument
+		err := s.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&doc)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			break
+		}
+		if err != nil {
+			return links, fmt.Errorf("%s: claim document: %w", op, err)
+		}
+		links = append(links, doc.toModel())
+	}
+	return links, nil
+}
+
+// SetHealth stores the health of the destination of a live link, unless the
+// destination has changed to something other than url meanwhile.
+func (s *Storage) SetHealth(ctx context.Context, alias, url string, h models.Health) error {
+	const op = "storage.mongodb.SetHealth"
+
+	filter := bson.D{{Key: "alias", Value: alias}, {Key: "url", Value: url}, notDeleted}
+	update := bson.D{
+		{Key: "$set", Value: bson.D{{Key: "health", Value: toHealthDocument(h)}}},
+		{Key: "$unset", Value: bson.D{{Key: "health_lease", Value: ""}}},
+	}
+
+	res, err := s.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("%s: update document: %w", op, err)
+	}
+	if res.MatchedCount == 0 {
+		return storage.ErrURLNotFound
+	}
+
+	return nil
+}
+
+func toHealthDocument(h models.Health) *HealthDocument {
+	if h.IsZero() {
+		return nil
+	}
+	return &HealthDocument{
+		Status:        h.Status,
+		Error:         h.Error,
+		Latency:       h.Latency,
+		CheckedAt:     h.CheckedAt,
+		LastHealthyAt: timePtr(h.LastHealthyAt),
+		Failures:      h.Failures,
+		Broken:        h.Broken,
+	}
+}
+
+func (d *HealthDocument) toModel() models.Health {
+	if d == nil {
+		return models.Health{}
+	}
+	return models.Health{
+		Status:        d.Status,
+		Error:         d.Error,
+		Latency:       d.Latency,
+		CheckedAt:     d.CheckedAt,
+		LastHealthyAt: timeValue(d.LastHealthyAt),
+		Failures:      d.Failures,
+		Broken:        d.Broken,
+	}
+}
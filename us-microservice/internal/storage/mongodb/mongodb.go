@@ -2,25 +2,130 @@ package mongodb
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
 	"time"
+	"urlSh/internal/domain/models"
 	"urlSh/internal/storage"
 
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.mongodb.org/mongo-driver/mongo/readpref"
 )
 
 type Storage struct {
-	client     *mongo.Client
-	collection *mongo.Collection
+	client             *mongo.Client
+	collection         *mongo.Collection
+	counterCollection  *mongo.Collection
+	templateCollection *mongo.Collection
 }
 
 type URLDocument struct {
-	Alias string `bson:"alias"`
-	URL   string `bson:"url"`
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	Alias     string             `bson:"alias"`
+	URL       string             `bson:"url"`
+	UserId    int64              `bson:"user_id"`
+	Title     string             `bson:"title,omitempty"`
+	CreatedAt time.Time          `bson:"created_at"`
+
+	DisabledAt *time.Time `bson:"disabled_at,omitempty"`
+	ActiveFrom *time.Time `bson:"active_from,omitempty"`
+	ExpiresAt  *time.Time `bson:"expires_at,omitempty"`
+	MaxClicks  int64      `bson:"max_clicks,omitempty"`
+	// PasswordHash is the bcrypt hash of the link passphrase.
+	PasswordHash string `bson:"password_hash,omitempty"`
+	// DeletedAt marks a tombstone. The document, and with it the alias, is
+	// removed by the TTL index once PurgeAt has passed. Expiring links get a
+	// PurgeAt at creation so they are swept the same way.
+	DeletedAt *time.Time `bson:"deleted_at,omitempty"`
+	PurgeAt   *time.Time `bson:"purge_at,omitempty"`
+
+	Rules    []RuleDocument    `bson:"rules,omitempty"`
+	Variants []VariantDocument `bson:"variants,omitempty"`
+
+	Passthrough *PassthroughDocument `bson:"passthrough,omitempty"`
+	Redirect    *RedirectDocument    `bson:"redirect,omitempty"`
+	UTMTemplate string               `bson:"utm_template,omitempty"`
+	DeepLink    *DeepLinkDocument    `bson:"deep_link,omitempty"`
+	OwnerName   string               `bson:"owner_name,omitempty"`
+	Social      *SocialDocument      `bson:"social,omitempty"`
+	Page        *PageDocument        `bson:"page,omitempty"`
+	Health      *HealthDocument      `bson:"health,omitempty"`
+	// HealthLease keeps the link to the replica checking it until then.
+	HealthLease *time.Time `bson:"health_lease,omitempty"`
+}
+
+// PassthroughDocument is stored only for links forwarding the request, see models.Passthrough.
+type PassthroughDocument struct {
+	Path     bool   `bson:"path"`
+	Query    bool   `bson:"query"`
+	Conflict string `bson:"conflict"`
+}
+
+// RedirectDocument is stored only for links with a custom redirect, see models.Redirect.
+type RedirectDocument struct {
+	Status         int    `bson:"status,omitempty"`
+	CacheControl   string `bson:"cache_control,omitempty"`
+	ReferrerPolicy string `bson:"referrer_policy,omitempty"`
+	RobotsTag      string `bson:"robots_tag,omitempty"`
+}
+
+// SocialDocument is stored only for links with their own unfurl metadata, see models.SocialCard.
+type SocialDocument struct {
+	Title       string `bson:"title,omitempty"`
+	Description string `bson:"description,omitempty"`
+	Image       string `bson:"image,omitempty"`
+}
+
+// PageDocument is the fetched metadata of the destination, see models.PageMeta.
+type PageDocument struct {
+	Title       string    `bson:"title,omitempty"`
+	Description string    `bson:"description,omitempty"`
+	Favicon     string    `bson:"favicon,omitempty"`
+	FetchedAt   time.Time `bson:"fetched_at"`
+}
+
+// DeepLinkDocument is stored only for links opening an app, see models.DeepLink.
+type DeepLinkDocument struct {
+	IOS      string `bson:"ios,omitempty"`
+	Android  string `bson:"android,omitempty"`
+	Fallback string `bson:"fallback,omitempty"`
+}
+
+// VariantDocument is a destination of a split link, see models.Variant.
+type VariantDocument struct {
+	Name   string `bson:"name"`
+	URL    string `bson:"url"`
+	Weight int    `bson:"weight"`
+}
+
+// RuleDocument is a targeting rule of a link, see models.TargetRule.
+type RuleDocument struct {
+	OS        []string `bson:"os,omitempty"`
+	Languages []string `bson:"languages,omitempty"`
+	Countries []string `bson:"countries,omitempty"`
+	UserAgent string   `bson:"user_agent,omitempty"`
+	URL       string   `bson:"url"`
+}
+
+// notDeleted excludes tombstones from queries.
+var notDeleted = bson.E{Key: "deleted_at", Value: bson.D{{Key: "$exists", Value: false}}}
+
+// defaultListLimit and maxListLimit bound the page size of ListURLs.
+const (
+	defaultListLimit = 20
+	maxListLimit     = 100
+)
+
+type Counter struct {
+	ID  string `bson:"_id"`
+	Seq int64  `bson:"seq"`
 }
 
 func New(uri, database, collection string) (*Storage, error) {
@@ -42,28 +147,68 @@ func New(uri, database, collection string) (*Storage, error) {
 
 	db := client.Database(database)
 	coll := db.Collection(collection)
+	counterColl := db.Collection("counters")
+	templateColl := db.Collection("utm_templates")
 
-	indexModel := mongo.IndexModel{
-		Keys:    bson.D{{Key: "alias", Value: 1}},
-		Options: options.Index().SetUnique(true),
+	indexModels := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "alias", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "user_id", Value: 1}, {Key: "created_at", Value: -1}, {Key: "_id", Value: -1}},
+		},
+		{
+			Keys:    bson.D{{Key: "purge_at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+		{
+			Keys:    bson.D{{Key: "utm_template", Value: 1}, {Key: "alias", Value: 1}},
+			Options: options.Index().SetSparse(true),
+		},
+		{
+			Keys: bson.D{{Key: "health.checked_at", Value: 1}},
+		},
 	}
 
-	_, err = coll.Indexes().CreateOne(ctx, indexModel)
+	_, err = coll.Indexes().CreateMany(ctx, indexModels)
 	if err != nil {
 		return nil, fmt.Errorf("%s: create index: %w", op, err)
 	}
 
-	return &Storage{client: client, collection: coll}, nil
+	_, err = templateColl.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "user_id", Value: 1}, {Key: "name", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: create template index: %w", op, err)
+	}
+
+	return &Storage{client: client, collection: coll, counterCollection: counterColl, templateCollection: templateColl}, nil
 }
 
-func (s *Storage) SaveURL(ctx context.Context, urlToSave, alias string) (string, error) {
-	const op = "storage.mongodb.SaveURL"
+// NextSequence atomically increments the named counter and returns its new value.
+func (s *Storage) NextSequence(ctx context.Context, name string) (int64, error) {
+	const op = "storage.mongodb.NextSequence"
+
+	filter := bson.M{"_id": name}
+	update := bson.M{"$inc": bson.M{"seq": 1}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After).SetUpsert(true)
 
-	doc := URLDocument{
-		Alias: alias,
-		URL:   urlToSave,
+	var counter Counter
+	err := s.counterCollection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&counter)
+	if err != nil {
+		return 0, fmt.Errorf("%s: find and update sequence: %w", op, err)
 	}
 
+	return counter.Seq, nil
+}
+
+func (s *Storage) SaveURL(ctx context.Context, link models.Link) (string, error) {
+	const op = "storage.mongodb.SaveURL"
+
+	doc := toDocument(link)
+
 	_, err := s.collection.InsertOne(ctx, doc)
 	if err != nil {
 		var mongoWriteException mongo.WriteException
@@ -80,19 +225,429 @@ func (s *Storage) SaveURL(ctx context.Context, urlToSave, alias string) (string,
 	return doc.Alias, nil
 }
 
-func (s *Storage) GetURL(ctx context.Context, alias string) (string, error) {
+// SaveURLs inserts the links with a single unordered InsertMany. The returned
+// slice holds one error per link, nil for the links that were stored.
+func (s *Storage) SaveURLs(ctx context.Context, links []models.Link) []error {
+	const op = "storage.mongodb.SaveURLs"
+
+	errs := make([]error, len(links))
+	if len(links) == 0 {
+		return errs
+	}
+
+	docs := make([]interface{}, 0, len(links))
+	for _, link := range links {
+		docs = append(docs, toDocument(link))
+	}
+
+	_, err := s.collection.InsertMany(ctx, docs, options.InsertMany().SetOrdered(false))
+	if err == nil {
+		return errs
+	}
+
+	var bulkErr mongo.BulkWriteException
+	if !errors.As(err, &bulkErr) || bulkErr.WriteConcernError != nil {
+		for i := range errs {
+			errs[i] = fmt.Errorf("%s: insert documents: %w", op, err)
+		}
+		return errs
+	}
+
+	for _, writeError := range bulkErr.WriteErrors {
+		if writeError.Code == 11000 {
+			errs[writeError.Index] = fmt.Errorf("%s: %w", op, storage.ErrURLExists)
+		} else {
+			errs[writeError.Index] = fmt.Errorf("%s: insert document: %w", op, writeError)
+		}
+	}
+
+	return errs
+}
+
+func (s *Storage) GetURL(ctx context.Context, alias string) (models.Link, error) {
 	const op = "storage.mongodb.GetURL"
 
 	var doc URLDocument
-	filter := bson.D{{Key: "alias", Value: alias}}
+	filter := bson.D{{Key: "alias", Value: alias}, notDeleted}
 
 	err := s.collection.FindOne(ctx, filter).Decode(&doc)
 	if err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
-			return "", storage.ErrURLNotFound
+			return models.Link{}, storage.ErrURLNotFound
+		}
+		return models.Link{}, fmt.Errorf("%s: find document: %w", op, err)
+	}
+
+	return doc.toModel(), nil
+}
+
+// UpdateURL changes the destination of a link owned by userId from from to
+// urlToSave and returns the updated link. ErrURLChanged is returned when the
+// destination is no longer from. The metadata and health of the old
+// destination are dropped.
+func (s *Storage) UpdateURL(ctx context.Context, alias string, userId int64, from, urlToSave string) (models.Link, error) {
+	const op = "storage.mongodb.UpdateURL"
+
+	filter := bson.D{{Key: "alias", Value: alias}, {Key: "user_id", Value: userId}, notDeleted, {Key: "url", Value: from}}
+	update := bson.D{
+		{Key: "$set", Value: bson.D{{Key: "url", Value: urlToSave}}},
+		{Key: "$unset", Value: bson.D{{Key: "page", Value: ""}, {Key: "health", Value: ""}, {Key: "health_lease", Value: ""}}},
+	}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var doc URLDocument
+	err := s.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&doc)
+	if err == nil {
+		return doc.toModel(), nil
+	}
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		return models.Link{}, fmt.Errorf("%s: update document: %w", op, err)
+	}
+
+	n, err := s.collection.CountDocuments(ctx, filter[:3], options.Count().SetLimit(1))
+	if err != nil {
+		return models.Link{}, fmt.Errorf("%s: count documents: %w", op, err)
+	}
+	if n > 0 {
+		return models.Link{}, storage.ErrURLChanged
+	}
+	return models.Link{}, s.missingOrForeign(ctx, op, alias)
+}
+
+// SetPageMeta stores the metadata of the destination of a live link, unless
+// the destination has changed to something other than url meanwhile.
+func (s *Storage) SetPageMeta(ctx context.Context, alias, url string, meta models.PageMeta) error {
+	const op = "storage.mongodb.SetPageMeta"
+
+	doc := PageDocument(meta)
+	filter := bson.D{{Key: "alias", Value: alias}, {Key: "url", Value: url}, notDeleted}
+	_, err := s.collection.UpdateOne(ctx, filter, bson.D{{Key: "$set", Value: bson.D{{Key: "page", Value: doc}}}})
+	if err != nil {
+		return fmt.Errorf("%s: update document: %w", op, err)
+	}
+
+	return nil
+}
+
+// SetDisabled disables or re-enables a link owned by userId and returns the updated link.
+func (s *Storage) SetDisabled(ctx context.Context, alias string, userId int64, disabled bool) (models.Link, error) {
+	const op = "storage.mongodb.SetDisabled"
+
+	update := bson.D{{Key: "$unset", Value: bson.D{{Key: "disabled_at", Value: ""}}}}
+	if disabled {
+		update = bson.D{{Key: "$set", Value: bson.D{{Key: "disabled_at", Value: time.Now().UTC()}}}}
+	}
+
+	return s.updateOwned(ctx, op, alias, userId, update)
+}
+
+// DeleteURL turns a link owned by userId into a tombstone. The alias stays
+// reserved until purgeAt, after which the TTL index removes the document.
+func (s *Storage) DeleteURL(ctx context.Context, alias string, userId int64, purgeAt time.Time) error {
+	const op = "storage.mongodb.DeleteURL"
+
+	update := bson.D{{Key: "$set", Value: bson.D{
+		{Key: "deleted_at", Value: time.Now().UTC()},
+		{Key: "purge_at", Value: purgeAt},
+	}}}
+
+	_, err := s.updateOwned(ctx, op, alias, userId, update)
+	return err
+}
+
+// updateOwned applies update to the live link with the given alias if it belongs to userId.
+func (s *Storage) updateOwned(ctx context.Context, op, alias string, userId int64, update bson.D) (models.Link, error) {
+	filter := bson.D{{Key: "alias", Value: alias}, {Key: "user_id", Value: userId}, notDeleted}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var doc URLDocument
+	err := s.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return models.Link{}, s.missingOrForeign(ctx, op, alias)
+		}
+		return models.Link{}, fmt.Errorf("%s: update document: %w", op, err)
+	}
+
+	return doc.toModel(), nil
+}
+
+// missingOrForeign tells apart an alias that does not exist from one owned by
+// someone else after an owner-scoped query matched nothing.
+func (s *Storage) missingOrForeign(ctx context.Context, op, alias string) error {
+	n, err := s.collection.CountDocuments(ctx, bson.D{{Key: "alias", Value: alias}, notDeleted}, options.Count().SetLimit(1))
+	if err != nil {
+		return fmt.Errorf("%s: count documents: %w", op, err)
+	}
+	if n == 0 {
+		return storage.ErrURLNotFound
+	}
+	return storage.ErrNotOwner
+}
+
+// ListURLs returns a page of the user's links ordered by creation time
+// together with the cursor of the next page.
+func (s *Storage) ListURLs(ctx context.Context, opts models.ListOptions) ([]models.Link, string, error) {
+	const op = "storage.mongodb.ListURLs"
+
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = defaultListLimit
+	}
+	limit = min(limit, maxListLimit)
+
+	order, cmp := -1, "$lt"
+	if opts.OldestFirst {
+		order, cmp = 1, "$gt"
+	}
+
+	filter := bson.D{{Key: "user_id", Value: opts.UserId}, notDeleted}
+	if opts.Query != "" {
+		filter = append(filter, bson.E{Key: "url", Value: primitive.Regex{Pattern: regexp.QuoteMeta(opts.Query), Options: "i"}})
+	}
+	if opts.Cursor != "" {
+		createdAt, id, err := decodeCursor(opts.Cursor)
+		if err != nil {
+			return nil, "", fmt.Errorf("%s: %w", op, err)
 		}
-		return "", fmt.Errorf("%s: find document: %w", op, err)
+		filter = append(filter, bson.E{Key: "$or", Value: bson.A{
+			bson.D{{Key: "created_at", Value: bson.D{{Key: cmp, Value: createdAt}}}},
+			bson.D{{Key: "created_at", Value: createdAt}, {Key: "_id", Value: bson.D{{Key: cmp, Value: id}}}},
+		}})
+	}
+
+	findOpts := options.Find().
+		SetSort(bson.D{{Key: "created_at", Value: order}, {Key: "_id", Value: order}}).
+		SetLimit(int64(limit) + 1)
+
+	cur, err := s.collection.Find(ctx, filter, findOpts)
+	if err != nil {
+		return nil, "", fmt.Errorf("%s: find documents: %w", op, err)
+	}
+
+	var docs []URLDocument
+	if err := cur.All(ctx, &docs); err != nil {
+		return nil, "", fmt.Errorf("%s: decode documents: %w", op, err)
+	}
+
+	var next string
+	if len(docs) > limit {
+		docs = docs[:limit]
+		last := docs[len(docs)-1]
+		next = encodeCursor(last.CreatedAt, last.ID)
+	}
+
+	links := make([]models.Link, 0, len(docs))
+	for _, doc := range docs {
+		links = append(links, doc.toModel())
+	}
+
+	return links, next, nil
+}
+
+func toDocument(link models.Link) URLDocument {
+	return URLDocument{
+		Alias:       link.Alias,
+		URL:         link.URL,
+		UserId:      link.UserId,
+		Title:       link.Title,
+		CreatedAt:   link.CreatedAt,
+		ActiveFrom:  timePtr(link.ActiveFrom),
+		ExpiresAt:   timePtr(link.ExpiresAt),
+		MaxClicks:   link.MaxClicks,
+		PurgeAt:     timePtr(link.PurgeAt),
+		Rules:       toRuleDocuments(link.Rules),
+		Variants:    toVariantDocuments(link.Variants),
+		Passthrough: toPassthroughDocument(link.Passthrough),
+		Redirect:    toRedirectDocument(link.Redirect),
+		UTMTemplate: link.UTMTemplate,
+		DeepLink:    toDeepLinkDocument(link.DeepLink),
+		OwnerName:   link.OwnerName,
+		Social:      toSocialDocument(link.Social),
+
+		PasswordHash: string(link.PasswordHash),
+	}
+}
+
+func toRuleDocuments(rules []models.TargetRule) []RuleDocument {
+	if len(rules) == 0 {
+		return nil
+	}
+	docs := make([]RuleDocument, 0, len(rules))
+	for _, r := range rules {
+		docs = append(docs, RuleDocument(r))
+	}
+	return docs
+}
+
+func (d URLDocument) toModel() models.Link {
+	return models.Link{
+		Alias:       d.Alias,
+		URL:         d.URL,
+		UserId:      d.UserId,
+		Title:       d.Title,
+		CreatedAt:   d.CreatedAt,
+		Disabled:    d.DisabledAt != nil,
+		ActiveFrom:  timeValue(d.ActiveFrom),
+		ExpiresAt:   timeValue(d.ExpiresAt),
+		MaxClicks:   d.MaxClicks,
+		PurgeAt:     timeValue(d.PurgeAt),
+		Rules:       toRules(d.Rules),
+		Variants:    toVariants(d.Variants),
+		Passthrough: d.Passthrough.toModel(),
+		Redirect:    d.Redirect.toModel(),
+		UTMTemplate: d.UTMTemplate,
+		DeepLink:    d.DeepLink.toModel(),
+		OwnerName:   d.OwnerName,
+		Social:      d.Social.toModel(),
+		Page:        d.Page.toModel(),
+		Health:      d.Health.toModel(),
+
+		PasswordHash: []byte(d.PasswordHash),
+	}
+}
+
+func toPassthroughDocument(p models.Passthrough) *PassthroughDocument {
+	if !p.Enabled() {
+		return nil
+	}
+	doc := PassthroughDocument(p)
+	return &doc
+}
+
+func (d *PassthroughDocument) toModel() models.Passthrough {
+	if d == nil {
+		return models.Passthrough{}
+	}
+	return models.Passthrough(*d)
+}
+
+func toRedirectDocument(r models.Redirect) *RedirectDocument {
+	if r.IsZero() {
+		return nil
+	}
+	doc := RedirectDocument(r)
+	return &doc
+}
+
+func (d *RedirectDocument) toModel() models.Redirect {
+	if d == nil {
+		return models.Redirect{}
+	}
+	return models.Redirect(*d)
+}
+
+func toSocialDocument(s models.SocialCard) *SocialDocument {
+	if s.IsZero() {
+		return nil
+	}
+	doc := SocialDocument(s)
+	return &doc
+}
+
+func (d *SocialDocument) toModel() models.SocialCard {
+	if d == nil {
+		return models.SocialCard{}
+	}
+	return models.SocialCard(*d)
+}
+
+func (d *PageDocument) toModel() models.PageMeta {
+	if d == nil {
+		return models.PageMeta{}
+	}
+	return models.PageMeta(*d)
+}
+
+func toDeepLinkDocument(dl models.DeepLink) *DeepLinkDocument {
+	if !dl.Enabled() {
+		return nil
+	}
+	doc := DeepLinkDocument(dl)
+	return &doc
+}
+
+func (d *DeepLinkDocument) toModel() models.DeepLink {
+	if d == nil {
+		return models.DeepLink{}
+	}
+	return models.DeepLink(*d)
+}
+
+func toVariantDocuments(variants []models.Variant) []VariantDocument {
+	if len(variants) == 0 {
+		return nil
+	}
+	docs := make([]VariantDocument, 0, len(variants))
+	for _, v := range variants {
+		docs = append(docs, VariantDocument(v))
+	}
+	return docs
+}
+
+func toVariants(docs []VariantDocument) []models.Variant {
+	if len(docs) == 0 {
+		return nil
+	}
+	variants := make([]models.Variant, 0, len(docs))
+	for _, d := range docs {
+		variants = append(variants, models.Variant(d))
+	}
+	return variants
+}
+
+func toRules(docs []RuleDocument) []models.TargetRule {
+	if len(docs) == 0 {
+		return nil
+	}
+	rules := make([]models.TargetRule, 0, len(docs))
+	for _, d := range docs {
+		rules = append(rules, models.TargetRule(d))
+	}
+	return rules
+}
+
+// timePtr maps the zero time to a missing field.
+func timePtr(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+func timeValue(t *time.Time) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return *t
+}
+
+// encodeCursor packs the sort key of the last returned document into an opaque string.
+func encodeCursor(createdAt time.Time, id primitive.ObjectID) string {
+	raw := strconv.FormatInt(createdAt.UnixMilli(), 10) + ":" + id.Hex()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func decodeCursor(cursor string) (time.Time, primitive.ObjectID, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return time.Time{}, primitive.NilObjectID, storage.ErrInvalidCursor
+	}
+
+	millis, hex, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return time.Time{}, primitive.NilObjectID, storage.ErrInvalidCursor
+	}
+
+	ms, err := strconv.ParseInt(millis, 10, 64)
+	if err != nil {
+		return time.Time{}, primitive.NilObjectID, storage.ErrInvalidCursor
+	}
+
+	id, err := primitive.ObjectIDFromHex(hex)
+	if err != nil {
+		return time.Time{}, primitive.NilObjectID, storage.ErrInvalidCursor
 	}
 
-	return doc.URL, nil
+	return time.UnixMilli(ms).UTC(), id, nil
 }
@@ -0,0 +1,45 @@
+package mongodb
+
+import (
+	"encoding/base64"
+	"errors"
+	"testing"
+	"time"
+	"urlSh/internal/storage"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	createdAt := time.Date(2024, 5, 1, 12, 30, 0, 123_000_000, time.UTC)
+	id := primitive.NewObjectID()
+
+	gotTime, gotID, err := decodeCursor(encodeCursor(createdAt, id))
+	if err != nil {
+		t.Fatalf("decodeCursor: %v", err)
+	}
+	if !gotTime.Equal(createdAt) || gotID != id {
+		t.Errorf("got %v, %s; want %v, %s", gotTime, gotID.Hex(), createdAt, id.Hex())
+	}
+}
+
+func TestDecodeCursorInvalid(t *testing.T) {
+	encode := func(raw string) string { return base64.RawURLEncoding.EncodeToString([]byte(raw)) }
+
+	tests := []struct {
+		name   string
+		cursor string
+	}{
+		{"not base64", "!!!"},
+		{"no separator", encode("1714566600123")},
+		{"bad time", encode("yesterday:" + primitive.NewObjectID().Hex())},
+		{"bad id", encode("1714566600123:xyz")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := decodeCursor(tt.cursor); !errors.Is(err, storage.ErrInvalidCursor) {
+				t.Errorf("got %v, want %v", err, storage.ErrInvalidCursor)
+			}
+		})
+	}
+}
@@ -0,0 +1,272 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"urlSh/internal/domain/models"
+	"urlSh/internal/storage"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// TemplateDocument is a UTM template, see models.UTMTemplate.
+type TemplateDocument struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	UserId    int64              `bson:"user_id"`
+	Name      string             `bson:"name"`
+	Source    string             `bson:"source,omitempty"`
+	Medium    string             `bson:"medium,omitempty"`
+	Campaign  string             `bson:"campaign,omitempty"`
+	Term      string             `bson:"term,omitempty"`
+	Content   string             `bson:"content,omitempty"`
+	CreatedAt time.Time          `bson:"created_at"`
+	UpdatedAt time.Time          `bson:"updated_at"`
+	Pending   []string           `bson:"pending,omitempty"`
+}
+
+// SaveTemplate stores a new template and returns it with its ID.
+func (s *Storage) SaveTemplate(ctx context.Context, t models.UTMTemplate) (models.UTMTemplate, error) {
+	const op = "storage.mongodb.SaveTemplate"
+
+	doc := toTemplateDocument(t)
+	doc.ID = primitive.NewObjectID()
+
+	if _, err := s.templateCollection.InsertOne(ctx, doc); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return models.UTMTemplate{}, fmt.Errorf("%s: %w", op, storage.ErrTemplateExists)
+		}
+		return models.UTMTemplate{}, fmt.Errorf("%s: insert document: %w", op, err)
+	}
+
+	return doc.toModel(), nil
+}
+
+// GetTemplate returns the template with the given ID if it belongs to userId.
+func (s *Storage) GetTemplate(ctx context.Context, id string, userId int64) (models.UTMTemplate, error) {
+	const op = "storage.mongodb.GetTemplate"
+
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return models.UTMTemplate{}, storage.ErrTemplateNotFound
+	}
+
+	var doc TemplateDocument
+	err = s.templateCollection.FindOne(ctx, bson.D{{Key: "_id", Value: oid}, {Key: "user_id", Value: userId}}).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return models.UTMTemplate{}, storage.ErrTemplateNotFound
+		}
+		return models.UTMTemplate{}, fmt.Errorf("%s: find document: %w", op, err)
+	}
+
+	return doc.toModel(), nil
+}
+
+// ListTemplates returns the templates of userId ordered by name.
+func (s *Storage) ListTemplates(ctx context.Context, userId int64) ([]models.UTMTemplate, error) {
+	const op = "storage.mongodb.ListTemplates"
+
+	cur, err := s.templateCollection.Find(ctx, bson.D{{Key: "user_id", Value: userId}},
+		options.Find().SetSort(bson.D{{Key: "name", Value: 1}}))
+	if err != nil {
+		return nil, fmt.Errorf("%s: find documents: %w", op, err)
+	}
+
+	var docs []TemplateDocument
+	if err := cur.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("%s: decode documents: %w", op, err)
+	}
+
+	templates := make([]models.UTMTemplate, 0, len(docs))
+	for _, d := range docs {
+		templates = append(templates, d.toModel())
+	}
+	return templates, nil
+}
+
+// UpdateTemplate replaces the name, parameters and pending parameters of a
+// template owned by t.UserId and returns the updated template.
+func (s *Storage) UpdateTemplate(ctx context.Context, t models.UTMTemplate) (models.UTMTemplate, error) {
+	const op = "storage.mongodb.UpdateTemplate"
+
+	oid, err := primitive.ObjectIDFromHex(t.ID)
+	if err != nil {
+		return models.UTMTemplate{}, storage.ErrTemplateNotFound
+	}
+
+	update := bson.D{{Key: "$set", Value: bson.D{
+		{Key: "name", Value: t.Name},
+		{Key: "source", Value: t.Source},
+		{Key: "medium", Value: t.Medium},
+		{Key: "campaign", Value: t.Campaign},
+		{Key: "term", Value: t.Term},
+		{Key: "content", Value: t.Content},
+		{Key: "updated_at", Value: t.UpdatedAt},
+		{Key: "pending", Value: t.Pending},
+	}}}
+	filter := bson.D{{Key: "_id", Value: oid}, {Key: "user_id", Value: t.UserId}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var doc TemplateDocument
+	err = s.templateCollection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return models.UTMTemplate{}, storage.ErrTemplateNotFound
+		}
+		if mongo.IsDuplicateKeyError(err) {
+			return models.UTMTemplate{}, fmt.Errorf("%s: %w", op, storage.ErrTemplateExists)
+		}
+		return models.UTMTemplate{}, fmt.Errorf("%s: update document: %w", op, err)
+	}
+
+	return doc.toModel(), nil
+}
+
+// DeleteTemplate removes a template owned by userId. Links created with it
+// keep their parameters but no longer refer to it.
+func (s *Storage) DeleteTemplate(ctx context.Context, id string, userId int64) error {
+	const op = "storage.mongodb.DeleteTemplate"
+
+	oid, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return storage.ErrTemplateNotFound
+	}
+
+	res, err := s.templateCollection.DeleteOne(ctx, bson.D{{Key: "_id", Value: oid}, {Key: "user_id", Value: userId}})
+	if err != nil {
+		return fmt.Errorf("%s: delete document: %w", op, err)
+	}
+	if res.DeletedCount == 0 {
+		return storage.ErrTemplateNotFound
+	}
+
+	_, err = s.collection.UpdateMany(ctx,
+		bson.D{{Key: "utm_template", Value: id}},
+		bson.D{{Key: "$unset", Value: bson.D{{Key: "utm_template", Value: ""}}}})
+	if err != nil {
+		return fmt.Errorf("%s: unset links: %w", op, err)
+	}
+
+	return nil
+}
+
+// ListTemplateURLs returns up to limit live links created with the template
+// whose alias sorts after the given one, ordered by alias.
+func (s *Storage) ListTemplateURLs(ctx context.Context, templateID, after string, limit int) ([]models.Link, error) {
+	const op = "storage.mongodb.ListTemplateURLs"
+
+	filter := bson.D{{Key: "utm_template", Value: templateID}, notDeleted}
+	if after != "" {
+		filter = append(filter, bson.E{Key: "alias", Value: bson.D{{Key: "$gt", Value: after}}})
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "alias", Value: 1}}).SetLimit(int64(limit))
+
+	cur, err := s.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("%s: find documents: %w", op, err)
+	}
+
+	var docs []URLDocument
+	if err := cur.All(ctx, &docs); err != nil {
+		return nil, fmt.Errorf("%s: decode documents: %w", op, err)
+	}
+
+	links := make([]models.Link, 0, len(docs))
+	for _, d := range docs {
+		links = append(links, d.toModel())
+	}
+	return links, nil
+}
+
+// UpdateDestinations stores the URL, rule and variant destinations of
+// updated[i] for the live link old[i], in one round trip. A link is only
+// written while its destinations are still those of old[i], so concurrent
+// edits are never overwritten. It returns the number of links changed and
+// the number of links skipped because they no longer matched.
+func (s *Storage) UpdateDestinations(ctx context.Context, old, updated []models.Link) (changed, skipped int, err error) {
+	const op = "storage.mongodb.UpdateDestinations"
+
+	if len(updated) == 0 {
+		return 0, 0, nil
+	}
+
+	writes := make([]mongo.WriteModel, 0, len(updated))
+	for i, link := range updated {
+		filter := bson.D{
+			{Key: "alias", Value: old[i].Alias},
+			notDeleted,
+			{Key: "url", Value: old[i].URL},
+			{Key: "rules", Value: toRuleDocuments(old[i].Rules)},
+			{Key: "variants", Value: toVariantDocuments(old[i].Variants)},
+		}
+		update := bson.D{{Key: "$set", Value: bson.D{
+			{Key: "url", Value: link.URL},
+			{Key: "rules", Value: toRuleDocuments(link.Rules)},
+			{Key: "variants", Value: toVariantDocuments(link.Variants)},
+		}}}
+		writes = append(writes, mongo.NewUpdateOneModel().SetFilter(filter).SetUpdate(update))
+	}
+
+	res, err := s.collection.BulkWrite(ctx, writes, options.BulkWrite().SetOrdered(false))
+	if err != nil {
+		return 0, 0, fmt.Errorf("%s: update documents: %w", op, err)
+	}
+
+	return int(res.ModifiedCount), len(writes) - int(res.MatchedCount), nil
+}
+
+// FinishPropagation clears the pending parameters of a template once its links
+// are re-tagged, unless the template was updated again in the meantime.
+func (s *Storage) FinishPropagation(ctx context.Context, t models.UTMTemplate) error {
+	const op = "storage.mongodb.FinishPropagation"
+
+	oid, err := primitive.ObjectIDFromHex(t.ID)
+	if err != nil {
+		return storage.ErrTemplateNotFound
+	}
+
+	_, err = s.templateCollection.UpdateOne(ctx,
+		bson.D{{Key: "_id", Value: oid}, {Key: "updated_at", Value: t.UpdatedAt}},
+		bson.D{{Key: "$unset", Value: bson.D{{Key: "pending", Value: ""}}}})
+	if err != nil {
+		return fmt.Errorf("%s: update document: %w", op, err)
+	}
+
+	return nil
+}
+
+func toTemplateDocument(t models.UTMTemplate) TemplateDocument {
+	return TemplateDocument{
+		UserId:    t.UserId,
+		Name:      t.Name,
+		Source:    t.Source,
+		Medium:    t.Medium,
+		Campaign:  t.Campaign,
+		Term:      t.Term,
+		Content:   t.Content,
+		CreatedAt: t.CreatedAt,
+		UpdatedAt: t.UpdatedAt,
+		Pending:   t.Pending,
+	}
+}
+
+func (d TemplateDocument) toModel() models.UTMTemplate {
+	return models.UTMTemplate{
+		ID:        d.ID.Hex(),
+		UserId:    d.UserId,
+		Name:      d.Name,
+		Source:    d.Source,
+		Medium:    d.Medium,
+		Campaign:  d.Campaign,
+		Term:      d.Term,
+		Content:   d.Content,
+		CreatedAt: d.CreatedAt,
+		UpdatedAt: d.UpdatedAt,
+		Pending:   d.Pending,
+	}
+}
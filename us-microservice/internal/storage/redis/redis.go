@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/redis/go-redis/v9"
 	"time"
+	"urlSh/internal/domain/models"
 )
 
 type Cache struct {
@@ -31,6 +32,17 @@ func (c *Cache) SaveURL(ctx context.Context, originalURL string, alias string, e
 	return c.client.Set(ctx, alias, originalURL, expiration).Err()
 }
 
+// SaveURLs stores many aliases in one pipelined round trip
+func (c *Cache) SaveURLs(ctx context.Context, entries []models.CacheEntry) error {
+	_, err := c.client.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, e := range entries {
+			pipe.Set(ctx, e.Alias, e.URL, e.Expiration)
+		}
+		return nil
+	})
+	return err
+}
+
 // GetURL retrieves the original URL from the cache by the alias
 func (c *Cache) GetURL(ctx context.Context, alias string) (string, error) {
 	result, err := c.client.Get(ctx, alias).Result()
@@ -43,6 +55,45 @@ func (c *Cache) GetURL(ctx context.Context, alias string) (string, error) {
 	return result, nil
 }
 
+// clicksKey is the key of the redirect counter of a click-limited alias.
+func clicksKey(alias string) string {
+	return "clicks:" + alias
+}
+
+// IncrClicks atomically counts one redirect of the alias and returns the new total.
+// INCR keeps the count exact when several replicas serve the same alias.
+func (c *Cache) IncrClicks(ctx context.Context, alias string) (int64, error) {
+	return c.client.Incr(ctx, clicksKey(alias)).Result()
+}
+
+// ResetClicks drops the redirect counter of the alias
+func (c *Cache) ResetClicks(ctx context.Context, alias string) error {
+	return c.client.Del(ctx, clicksKey(alias)).Err()
+}
+
+// CountUnlockAttempt counts a password attempt on the alias within a fixed
+// window and returns the number of attempts made in the current window.
+func (c *Cache) CountUnlockAttempt(ctx context.Context, alias string, window time.Duration) (int64, error) {
+	key := "unlock:" + alias
+
+	var incr *redis.IntCmd
+	_, err := c.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		incr = pipe.Incr(ctx, key)
+		pipe.ExpireNX(ctx, key, window)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return incr.Val(), nil
+}
+
+// DeleteURL evicts the alias from the cache
+func (c *Cache) DeleteURL(ctx context.Context, alias string) error {
+	return c.client.Del(ctx, alias).Err()
+}
+
 // Close closes the Redis client connection
 func (c *Cache) Close() error {
 	return c.client.Close()
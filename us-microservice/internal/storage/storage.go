@@ -2,9 +2,37 @@ package storage
 
 import (
 	"fmt"
+	"time"
 )
 
 var (
-	ErrURLNotFound = fmt.Errorf("url not found")
-	ErrURLExists   = fmt.Errorf("url already exists")
+	ErrURLNotFound     = fmt.Errorf("url not found")
+	ErrURLExists       = fmt.Errorf("url already exists")
+	ErrURLDisabled     = fmt.Errorf("url is disabled")
+	ErrURLExpired      = fmt.Errorf("url has expired")
+	ErrURLNotYetActive = fmt.Errorf("url is not active yet")
+	ErrClickLimit      = fmt.Errorf("url has reached its click limit")
+	ErrURLLocked       = fmt.Errorf("url is password protected")
+	ErrURLBlocked      = fmt.Errorf("url destination is blocked")
+	ErrURLChanged      = fmt.Errorf("url destination was changed concurrently")
+
+	ErrTemplateNotFound = fmt.Errorf("utm template not found")
+	ErrTemplateExists   = fmt.Errorf("utm template already exists")
+
+	ErrInvalidCursor = fmt.Errorf("invalid cursor")
+	ErrNotOwner      = fmt.Errorf("url belongs to another user")
 )
+
+// NotYetActiveError carries the activation time of a link whose window has not opened.
+// It matches ErrURLNotYetActive with errors.Is.
+type NotYetActiveError struct {
+	ActiveFrom time.Time
+}
+
+func (e *NotYetActiveError) Error() string {
+	return fmt.Sprintf("%s: active from %s", ErrURLNotYetActive, e.ActiveFrom.Format(time.RFC3339))
+}
+
+func (e *NotYetActiveError) Is(target error) bool {
+	return target == ErrURLNotYetActive
+}
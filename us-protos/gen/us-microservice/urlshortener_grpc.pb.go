@@ -0,0 +1,657 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.25.1
+// source: proto/us-service/urlshortener.proto
+
+package us_microservice
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	UrlShorteningService_ShortenUrl_FullMethodName        = "/urlSh.UrlShorteningService/ShortenUrl"
+	UrlShorteningService_ShortenUrls_FullMethodName       = "/urlSh.UrlShorteningService/ShortenUrls"
+	UrlShorteningService_GetOriginalUrl_FullMethodName    = "/urlSh.UrlShorteningService/GetOriginalUrl"
+	UrlShorteningService_ListUserUrls_FullMethodName      = "/urlSh.UrlShorteningService/ListUserUrls"
+	UrlShorteningService_UpdateUrl_FullMethodName         = "/urlSh.UrlShorteningService/UpdateUrl"
+	UrlShorteningService_DeleteUrl_FullMethodName         = "/urlSh.UrlShorteningService/DeleteUrl"
+	UrlShorteningService_DisableUrl_FullMethodName        = "/urlSh.UrlShorteningService/DisableUrl"
+	UrlShorteningService_UnlockUrl_FullMethodName         = "/urlSh.UrlShorteningService/UnlockUrl"
+	UrlShorteningService_CreateUtmTemplate_FullMethodName = "/urlSh.UrlShorteningService/CreateUtmTemplate"
+	UrlShorteningService_GetUtmTemplate_FullMethodName    = "/urlSh.UrlShorteningService/GetUtmTemplate"
+	UrlShorteningService_ListUtmTemplates_FullMethodName  = "/urlSh.UrlShorteningService/ListUtmTemplates"
+	UrlShorteningService_UpdateUtmTemplate_FullMethodName = "/urlSh.UrlShorteningService/UpdateUtmTemplate"
+	UrlShorteningService_DeleteUtmTemplate_FullMethodName = "/urlSh.UrlShorteningService/DeleteUtmTemplate"
+	UrlShorteningService_GetLinkPreview_FullMethodName    = "/urlSh.UrlShorteningService/GetLinkPreview"
+	UrlShorteningService_GetLinkHealth_FullMethodName     = "/urlSh.UrlShorteningService/GetLinkHealth"
+)
+
+// UrlShorteningServiceClient is the client API for UrlShorteningService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type UrlShorteningServiceClient interface {
+	// Shortens a given original URL and returns the shortened URL.
+	ShortenUrl(ctx context.Context, in *ShortenUrlRequest, opts ...grpc.CallOption) (*ShortenUrlResponse, error)
+	// Shortens many URLs at once. Every item succeeds or fails on its own.
+	ShortenUrls(ctx context.Context, in *ShortenUrlsRequest, opts ...grpc.CallOption) (*ShortenUrlsResponse, error)
+	// Retrieves the original URL for a given shortened URL.
+	GetOriginalUrl(ctx context.Context, in *GetOriginalUrlRequest, opts ...grpc.CallOption) (*GetOriginalUrlResponse, error)
+	// Lists the links created by a user, newest first unless asked otherwise.
+	ListUserUrls(ctx context.Context, in *ListUserUrlsRequest, opts ...grpc.CallOption) (*ListUserUrlsResponse, error)
+	// Changes the destination of a link. Only the owner may call it.
+	UpdateUrl(ctx context.Context, in *UpdateUrlRequest, opts ...grpc.CallOption) (*UpdateUrlResponse, error)
+	// Deletes a link. Its alias stays reserved for the quarantine period. Only the owner may call it.
+	DeleteUrl(ctx context.Context, in *DeleteUrlRequest, opts ...grpc.CallOption) (*DeleteUrlResponse, error)
+	// Disables or re-enables a link without releasing its alias. Only the owner may call it.
+	DisableUrl(ctx context.Context, in *DisableUrlRequest, opts ...grpc.CallOption) (*DisableUrlResponse, error)
+	// Retrieves the original URL of a password-protected link once the password matches.
+	UnlockUrl(ctx context.Context, in *UnlockUrlRequest, opts ...grpc.CallOption) (*UnlockUrlResponse, error)
+	// Creates a reusable set of utm_* parameters for the links of a user.
+	CreateUtmTemplate(ctx context.Context, in *CreateUtmTemplateRequest, opts ...grpc.CallOption) (*UtmTemplateResponse, error)
+	// Retrieves a UTM template. Only the owner may call it.
+	GetUtmTemplate(ctx context.Context, in *GetUtmTemplateRequest, opts ...grpc.CallOption) (*UtmTemplateResponse, error)
+	// Lists the UTM templates of a user ordered by name.
+	ListUtmTemplates(ctx context.Context, in *ListUtmTemplatesRequest, opts ...grpc.CallOption) (*ListUtmTemplatesResponse, error)
+	// Changes a UTM template and optionally re-tags the links created with it. Only the owner may call it.
+	UpdateUtmTemplate(ctx context.Context, in *UpdateUtmTemplateRequest, opts ...grpc.CallOption) (*UpdateUtmTemplateResponse, error)
+	// Deletes a UTM template. Links created with it keep their parameters. Only the owner may call it.
+	DeleteUtmTemplate(ctx context.Context, in *DeleteUtmTemplateRequest, opts ...grpc.CallOption) (*DeleteUtmTemplateResponse, error)
+	// Describes a link to visitors without following it or counting a click.
+	GetLinkPreview(ctx context.Context, in *GetLinkPreviewRequest, opts ...grpc.CallOption) (*GetLinkPreviewResponse, error)
+	// Retrieves the outcome of the periodic checks of a link's destination. Only the owner may call it.
+	GetLinkHealth(ctx context.Context, in *GetLinkHealthRequest, opts ...grpc.CallOption) (*GetLinkHealthResponse, error)
+}
+
+type urlShorteningServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUrlShorteningServiceClient(cc grpc.ClientConnInterface) UrlShorteningServiceClient {
+	return &urlShorteningServiceClient{cc}
+}
+
+func (c *urlShorteningServiceClient) ShortenUrl(ctx context.Context, in *ShortenUrlRequest, opts ...grpc.CallOption) (*ShortenUrlResponse, error) {
+	out := new(ShortenUrlResponse)
+	err := c.cc.Invoke(ctx, UrlShorteningService_ShortenUrl_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *urlShorteningServiceClient) ShortenUrls(ctx context.Context, in *ShortenUrlsRequest, opts ...grpc.CallOption) (*ShortenUrlsResponse, error) {
+	out := new(ShortenUrlsResponse)
+	err := c.cc.Invoke(ctx, UrlShorteningService_ShortenUrls_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *urlShorteningServiceClient) GetOriginalUrl(ctx context.Context, in *GetOriginalUrlRequest, opts ...grpc.CallOption) (*GetOriginalUrlResponse, error) {
+	out := new(GetOriginalUrlResponse)
+	err := c.cc.Invoke(ctx, UrlShorteningService_GetOriginalUrl_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *urlShorteningServiceClient) ListUserUrls(ctx context.Context, in *ListUserUrlsRequest, opts ...grpc.CallOption) (*ListUserUrlsResponse, error) {
+	out := new(ListUserUrlsResponse)
+	err := c.cc.Invoke(ctx, UrlShorteningService_ListUserUrls_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *urlShorteningServiceClient) UpdateUrl(ctx context.Context, in *UpdateUrlRequest, opts ...grpc.CallOption) (*UpdateUrlResponse, error) {
+	out := new(UpdateUrlResponse)
+	err := c.cc.Invoke(ctx, UrlShorteningService_UpdateUrl_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *urlShorteningServiceClient) DeleteUrl(ctx context.Context, in *DeleteUrlRequest, opts ...grpc.CallOption) (*DeleteUrlResponse, error) {
+	out := new(DeleteUrlResponse)
+	err := c.cc.Invoke(ctx, UrlShorteningService_DeleteUrl_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *urlShorteningServiceClient) DisableUrl(ctx context.Context, in *DisableUrlRequest, opts ...grpc.CallOption) (*DisableUrlResponse, error) {
+	out := new(DisableUrlResponse)
+	err := c.cc.Invoke(ctx, UrlShorteningService_DisableUrl_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *urlShorteningServiceClient) UnlockUrl(ctx context.Context, in *UnlockUrlRequest, opts ...grpc.CallOption) (*UnlockUrlResponse, error) {
+	out := new(UnlockUrlResponse)
+	err := c.cc.Invoke(ctx, UrlShorteningService_UnlockUrl_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *urlShorteningServiceClient) CreateUtmTemplate(ctx context.Context, in *CreateUtmTemplateRequest, opts ...grpc.CallOption) (*UtmTemplateResponse, error) {
+	out := new(UtmTemplateResponse)
+	err := c.cc.Invoke(ctx, UrlShorteningService_CreateUtmTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *urlShorteningServiceClient) GetUtmTemplate(ctx context.Context, in *GetUtmTemplateRequest, opts ...grpc.CallOption) (*UtmTemplateResponse, error) {
+	out := new(UtmTemplateResponse)
+	err := c.cc.Invoke(ctx, UrlShorteningService_GetUtmTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *urlShorteningServiceClient) ListUtmTemplates(ctx context.Context, in *ListUtmTemplatesRequest, opts ...grpc.CallOption) (*ListUtmTemplatesResponse, error) {
+	out := new(ListUtmTemplatesResponse)
+	err := c.cc.Invoke(ctx, UrlShorteningService_ListUtmTemplates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *urlShorteningServiceClient) UpdateUtmTemplate(ctx context.Context, in *UpdateUtmTemplateRequest, opts ...grpc.CallOption) (*UpdateUtmTemplateResponse, error) {
+	out := new(UpdateUtmTemplateResponse)
+	err := c.cc.Invoke(ctx, UrlShorteningService_UpdateUtmTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *urlShorteningServiceClient) DeleteUtmTemplate(ctx context.Context, in *DeleteUtmTemplateRequest, opts ...grpc.CallOption) (*DeleteUtmTemplateResponse, error) {
+	out := new(DeleteUtmTemplateResponse)
+	err := c.cc.Invoke(ctx, UrlShorteningService_DeleteUtmTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *urlShorteningServiceClient) GetLinkPreview(ctx context.Context, in *GetLinkPreviewRequest, opts ...grpc.CallOption) (*GetLinkPreviewResponse, error) {
+	out := new(GetLinkPreviewResponse)
+	err := c.cc.Invoke(ctx, UrlShorteningService_GetLinkPreview_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *urlShorteningServiceClient) GetLinkHealth(ctx context.Context, in *GetLinkHealthRequest, opts ...grpc.CallOption) (*GetLinkHealthResponse, error) {
+	out := new(GetLinkHealthResponse)
+	err := c.cc.Invoke(ctx, UrlShorteningService_GetLinkHealth_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UrlShorteningServiceServer is the server API for UrlShorteningService service.
+// All implementations must embed UnimplementedUrlShorteningServiceServer
+// for forward compatibility
+type UrlShorteningServiceServer interface {
+	// Shortens a given original URL and returns the shortened URL.
+	ShortenUrl(context.Context, *ShortenUrlRequest) (*ShortenUrlResponse, error)
+	// Shortens many URLs at once. Every item succeeds or fails on its own.
+	ShortenUrls(context.Context, *ShortenUrlsRequest) (*ShortenUrlsResponse, error)
+	// Retrieves the original URL for a given shortened URL.
+	GetOriginalUrl(context.Context, *GetOriginalUrlRequest) (*GetOriginalUrlResponse, error)
+	// Lists the links created by a user, newest first unless asked otherwise.
+	ListUserUrls(context.Context, *ListUserUrlsRequest) (*ListUserUrlsResponse, error)
+	// Changes the destination of a link. Only the owner may call it.
+	UpdateUrl(context.Context, *UpdateUrlRequest) (*UpdateUrlResponse, error)
+	// Deletes a link. Its alias stays reserved for the quarantine period. Only the owner may call it.
+	DeleteUrl(context.Context, *DeleteUrlRequest) (*DeleteUrlResponse, error)
+	// Disables or re-enables a link without releasing its alias. Only the owner may call it.
+	DisableUrl(context.Context, *DisableUrlRequest) (*DisableUrlResponse, error)
+	// Retrieves the original URL of a password-protected link once the password matches.
+	UnlockUrl(context.Context, *UnlockUrlRequest) (*UnlockUrlResponse, error)
+	// Creates a reusable set of utm_* parameters for the links of a user.
+	CreateUtmTemplate(context.Context, *CreateUtmTemplateRequest) (*UtmTemplateResponse, error)
+	// Retrieves a UTM template. Only the owner may call it.
+	GetUtmTemplate(context.Context, *GetUtmTemplateRequest) (*UtmTemplateResponse, error)
+	// Lists the UTM templates of a user ordered by name.
+	ListUtmTemplates(context.Context, *ListUtmTemplatesRequest) (*ListUtmTemplatesResponse, error)
+	// Changes a UTM template and optionally re-tags the links created with it. Only the owner may call it.
+	UpdateUtmTemplate(context.Context, *UpdateUtmTemplateRequest) (*UpdateUtmTemplateResponse, error)
+	// Deletes a UTM template. Links created with it keep their parameters. Only the owner may call it.
+	DeleteUtmTemplate(context.Context, *DeleteUtmTemplateRequest) (*DeleteUtmTemplateResponse, error)
+	// Describes a link to visitors without following it or counting a click.
+	GetLinkPreview(context.Context, *GetLinkPreviewRequest) (*GetLinkPreviewResponse, error)
+	// Retrieves the outcome of the periodic checks of a link's destination. Only the owner may call it.
+	GetLinkHealth(context.Context, *GetLinkHealthRequest) (*GetLinkHealthResponse, error)
+	mustEmbedUnimplementedUrlShorteningServiceServer()
+}
+
+// UnimplementedUrlShorteningServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedUrlShorteningServiceServer struct {
+}
+
+func (UnimplementedUrlShorteningServiceServer) ShortenUrl(context.Context, *ShortenUrlRequest) (*ShortenUrlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShortenUrl not implemented")
+}
+func (UnimplementedUrlShorteningServiceServer) ShortenUrls(context.Context, *ShortenUrlsRequest) (*ShortenUrlsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShortenUrls not implemented")
+}
+func (UnimplementedUrlShorteningServiceServer) GetOriginalUrl(context.Context, *GetOriginalUrlRequest) (*GetOriginalUrlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOriginalUrl not implemented")
+}
+func (UnimplementedUrlShorteningServiceServer) ListUserUrls(context.Context, *ListUserUrlsRequest) (*ListUserUrlsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserUrls not implemented")
+}
+func (UnimplementedUrlShorteningServiceServer) UpdateUrl(context.Context, *UpdateUrlRequest) (*UpdateUrlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUrl not implemented")
+}
+func (UnimplementedUrlShorteningServiceServer) DeleteUrl(context.Context, *DeleteUrlRequest) (*DeleteUrlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUrl not implemented")
+}
+func (UnimplementedUrlShorteningServiceServer) DisableUrl(context.Context, *DisableUrlRequest) (*DisableUrlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableUrl not implemented")
+}
+func (UnimplementedUrlShorteningServiceServer) UnlockUrl(context.Context, *UnlockUrlRequest) (*UnlockUrlResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockUrl not implemented")
+}
+func (UnimplementedUrlShorteningServiceServer) CreateUtmTemplate(context.Context, *CreateUtmTemplateRequest) (*UtmTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateUtmTemplate not implemented")
+}
+func (UnimplementedUrlShorteningServiceServer) GetUtmTemplate(context.Context, *GetUtmTemplateRequest) (*UtmTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUtmTemplate not implemented")
+}
+func (UnimplementedUrlShorteningServiceServer) ListUtmTemplates(context.Context, *ListUtmTemplatesRequest) (*ListUtmTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUtmTemplates not implemented")
+}
+func (UnimplementedUrlShorteningServiceServer) UpdateUtmTemplate(context.Context, *UpdateUtmTemplateRequest) (*UpdateUtmTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUtmTemplate not implemented")
+}
+func (UnimplementedUrlShorteningServiceServer) DeleteUtmTemplate(context.Context, *DeleteUtmTemplateRequest) (*DeleteUtmTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUtmTemplate not implemented")
+}
+func (UnimplementedUrlShorteningServiceServer) GetLinkPreview(context.Context, *GetLinkPreviewRequest) (*GetLinkPreviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLinkPreview not implemented")
+}
+func (UnimplementedUrlShorteningServiceServer) GetLinkHealth(context.Context, *GetLinkHealthRequest) (*GetLinkHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLinkHealth not implemented")
+}
+func (UnimplementedUrlShorteningServiceServer) mustEmbedUnimplementedUrlShorteningServiceServer() {}
+
+// UnsafeUrlShorteningServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UrlShorteningServiceServer will
+// result in compilation errors.
+type UnsafeUrlShorteningServiceServer interface {
+	mustEmbedUnimplementedUrlShorteningServiceServer()
+}
+
+func RegisterUrlShorteningServiceServer(s grpc.ServiceRegistrar, srv UrlShorteningServiceServer) {
+	s.RegisterService(&UrlShorteningService_ServiceDesc, srv)
+}
+
+func _UrlShorteningService_ShortenUrl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShortenUrlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlShorteningServiceServer).ShortenUrl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UrlShorteningService_ShortenUrl_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlShorteningServiceServer).ShortenUrl(ctx, req.(*ShortenUrlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UrlShorteningService_ShortenUrls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShortenUrlsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlShorteningServiceServer).ShortenUrls(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UrlShorteningService_ShortenUrls_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlShorteningServiceServer).ShortenUrls(ctx, req.(*ShortenUrlsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UrlShorteningService_GetOriginalUrl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOriginalUrlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlShorteningServiceServer).GetOriginalUrl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UrlShorteningService_GetOriginalUrl_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlShorteningServiceServer).GetOriginalUrl(ctx, req.(*GetOriginalUrlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UrlShorteningService_ListUserUrls_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserUrlsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlShorteningServiceServer).ListUserUrls(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UrlShorteningService_ListUserUrls_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlShorteningServiceServer).ListUserUrls(ctx, req.(*ListUserUrlsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UrlShorteningService_UpdateUrl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUrlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlShorteningServiceServer).UpdateUrl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UrlShorteningService_UpdateUrl_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlShorteningServiceServer).UpdateUrl(ctx, req.(*UpdateUrlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UrlShorteningService_DeleteUrl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUrlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlShorteningServiceServer).DeleteUrl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UrlShorteningService_DeleteUrl_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlShorteningServiceServer).DeleteUrl(ctx, req.(*DeleteUrlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UrlShorteningService_DisableUrl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableUrlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlShorteningServiceServer).DisableUrl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UrlShorteningService_DisableUrl_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlShorteningServiceServer).DisableUrl(ctx, req.(*DisableUrlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UrlShorteningService_UnlockUrl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockUrlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlShorteningServiceServer).UnlockUrl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UrlShorteningService_UnlockUrl_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlShorteningServiceServer).UnlockUrl(ctx, req.(*UnlockUrlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UrlShorteningService_CreateUtmTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUtmTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlShorteningServiceServer).CreateUtmTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UrlShorteningService_CreateUtmTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlShorteningServiceServer).CreateUtmTemplate(ctx, req.(*CreateUtmTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UrlShorteningService_GetUtmTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUtmTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlShorteningServiceServer).GetUtmTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UrlShorteningService_GetUtmTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlShorteningServiceServer).GetUtmTemplate(ctx, req.(*GetUtmTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UrlShorteningService_ListUtmTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUtmTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlShorteningServiceServer).ListUtmTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UrlShorteningService_ListUtmTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlShorteningServiceServer).ListUtmTemplates(ctx, req.(*ListUtmTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UrlShorteningService_UpdateUtmTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUtmTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlShorteningServiceServer).UpdateUtmTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UrlShorteningService_UpdateUtmTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlShorteningServiceServer).UpdateUtmTemplate(ctx, req.(*UpdateUtmTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UrlShorteningService_DeleteUtmTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUtmTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlShorteningServiceServer).DeleteUtmTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UrlShorteningService_DeleteUtmTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlShorteningServiceServer).DeleteUtmTemplate(ctx, req.(*DeleteUtmTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UrlShorteningService_GetLinkPreview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLinkPreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlShorteningServiceServer).GetLinkPreview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UrlShorteningService_GetLinkPreview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlShorteningServiceServer).GetLinkPreview(ctx, req.(*GetLinkPreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UrlShorteningService_GetLinkHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLinkHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UrlShorteningServiceServer).GetLinkHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UrlShorteningService_GetLinkHealth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UrlShorteningServiceServer).GetLinkHealth(ctx, req.(*GetLinkHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UrlShorteningService_ServiceDesc is the grpc.ServiceDesc for UrlShorteningService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UrlShorteningService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "urlSh.UrlShorteningService",
+	HandlerType: (*UrlShorteningServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ShortenUrl",
+			Handler:    _UrlShorteningService_ShortenUrl_Handler,
+		},
+		{
+			MethodName: "ShortenUrls",
+			Handler:    _UrlShorteningService_ShortenUrls_Handler,
+		},
+		{
+			MethodName: "GetOriginalUrl",
+			Handler:    _UrlShorteningService_GetOriginalUrl_Handler,
+		},
+		{
+			MethodName: "ListUserUrls",
+			Handler:    _UrlShorteningService_ListUserUrls_Handler,
+		},
+		{
+			MethodName: "UpdateUrl",
+			Handler:    _UrlShorteningService_UpdateUrl_Handler,
+		},
+		{
+			MethodName: "DeleteUrl",
+			Handler:    _UrlShorteningService_DeleteUrl_Handler,
+		},
+		{
+			MethodName: "DisableUrl",
+			Handler:    _UrlShorteningService_DisableUrl_Handler,
+		},
+		{
+			MethodName: "UnlockUrl",
+			Handler:    _UrlShorteningService_UnlockUrl_Handler,
+		},
+		{
+			MethodName: "CreateUtmTemplate",
+			Handler:    _UrlShorteningService_CreateUtmTemplate_Handler,
+		},
+		{
+			MethodName: "GetUtmTemplate",
+			Handler:    _UrlShorteningService_GetUtmTemplate_Handler,
+		},
+		{
+			MethodName: "ListUtmTemplates",
+			Handler:    _UrlShorteningService_ListUtmTemplates_Handler,
+		},
+		{
+			MethodName: "UpdateUtmTemplate",
+			Handler:    _UrlShorteningService_UpdateUtmTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteUtmTemplate",
+			Handler:    _UrlShorteningService_DeleteUtmTemplate_Handler,
+		},
+		{
+			MethodName: "GetLinkPreview",
+			Handler:    _UrlShorteningService_GetLinkPreview_Handler,
+		},
+		{
+			MethodName: "GetLinkHealth",
+			Handler:    _UrlShorteningService_GetLinkHealth_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/us-service/urlshortener.proto",
+}
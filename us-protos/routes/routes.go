@@ -0,0 +1,15 @@
+// Package routes lists the top-level paths the api-gateway serves next to
+// /{alias}. us-microservice never hands them out as aliases, and the gateway
+// refuses to start with a top-level route missing from this list.
+package routes
+
+// Gateway holds the first path segment of every fixed gateway route.
+var Gateway = []string{
+	"login",
+	"register",
+	"createUrl",
+	"getUrlStats",
+	"links",
+	"utm-templates",
+	".well-known",
+}